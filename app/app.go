@@ -19,6 +19,7 @@ import (
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 
 	treasuryclient "github.com/terra-project/core/x/treasury/client"
+	wasmclient "github.com/terra-project/core/x/wasm/client"
 
 	"github.com/terra-project/core/x/auth"
 	"github.com/terra-project/core/x/bank"
@@ -56,7 +57,12 @@ var (
 		bank.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, treasuryclient.TaxRateUpdateProposalHandler, treasuryclient.RewardWeightUpdateProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler,
+			treasuryclient.TaxRateUpdateProposalHandler, treasuryclient.RewardWeightUpdateProposalHandler,
+			treasuryclient.TaxExemptionAddProposalHandler, treasuryclient.TaxExemptionRemoveProposalHandler,
+			treasuryclient.TaxRateOverrideUpdateProposalHandler, treasuryclient.TaxCapUpdateProposalHandler,
+			wasmclient.StoreCodeProposalHandler, wasmclient.InstantiateContractProposalHandler,
+			wasmclient.ExecuteContractProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -74,6 +80,7 @@ var (
 		oracle.ModuleName:         nil,
 		distr.ModuleName:          nil,
 		treasury.ModuleName:       {supply.Minter},
+		treasury.BurnModuleName:   {supply.Burner},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
@@ -178,17 +185,20 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.marketKeeper = market.NewKeeper(app.cdc, keys[market.StoreKey], marketSubspace,
 		app.oracleKeeper, app.supplyKeeper, market.DefaultCodespace)
 	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], treasurySubspace,
-		app.supplyKeeper, app.marketKeeper, &stakingKeeper, app.distrKeeper,
+		app.supplyKeeper, app.marketKeeper, app.oracleKeeper, &stakingKeeper, app.distrKeeper,
 		oracle.ModuleName, distr.ModuleName, treasury.DefaultCodespace)
 
-	app.wasmKeeper = wasm.NewKeeper(app.cdc, keys[wasm.StoreKey], wasmSubspace, app.accountKeeper, app.bankKeeper, bApp.Router(), 3000000)
+	app.wasmKeeper = wasm.NewKeeper(app.cdc, keys[wasm.StoreKey], wasmSubspace, app.accountKeeper, app.bankKeeper,
+		app.supplyKeeper, app.treasuryKeeper, bApp.Router(), 3000000)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(params.RouterKey, treasury.NewParamChangeProposalHandler(app.treasuryKeeper,
+			params.NewParamChangeProposalHandler(app.paramsKeeper))).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper))
+		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper)).
+		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
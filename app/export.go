@@ -13,6 +13,7 @@ import (
 	"github.com/terra-project/core/x/oracle"
 	"github.com/terra-project/core/x/slashing"
 	"github.com/terra-project/core/x/staking"
+	"github.com/terra-project/core/x/treasury"
 )
 
 // ExportAppStateAndValidators exports the state of terra for a genesis file
@@ -203,5 +204,11 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []s
 	app.treasuryKeeper.ClearSRs(ctx)
 	app.treasuryKeeper.ClearTSLs(ctx)
 
+	app.treasuryKeeper.ClearTaxPayments(ctx)
+
+	// restart the epoch history from the zero height
+	app.treasuryKeeper.ClearEpochBoundaries(ctx)
+	app.treasuryKeeper.SetEpochBoundary(ctx, treasury.NewEpochBoundary(0, 0, app.treasuryKeeper.EpochLength(ctx)))
+
 	app.treasuryKeeper.RecordEpochInitialIssuance(ctx)
 }
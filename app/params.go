@@ -22,4 +22,7 @@ const (
 	OpWeightMsgUndelegate                                  = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate                             = "op_weight_msg_begin_redelegate"
 	OpWeightMsgUnjail                                      = "op_weight_msg_unjail"
+	OpWeightMsgStoreCode                                   = "op_weight_msg_store_code"
+	OpWeightMsgInstantiateContract                         = "op_weight_msg_instantiate_contract"
+	OpWeightMsgExecuteContract                             = "op_weight_msg_execute_contract"
 )
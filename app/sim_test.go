@@ -8,9 +8,11 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +20,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -44,6 +47,8 @@ import (
 	oraclesim "github.com/terra-project/core/x/oracle/simulation"
 	"github.com/terra-project/core/x/treasury"
 	treasurysim "github.com/terra-project/core/x/treasury/simulation"
+	"github.com/terra-project/core/x/wasm"
+	wasmsim "github.com/terra-project/core/x/wasm/simulation"
 )
 
 func init() {
@@ -73,6 +78,9 @@ func init() {
 	config.SetBech32PrefixForValidator(core.Bech32PrefixValAddr, core.Bech32PrefixValPub)
 	config.SetBech32PrefixForConsensusNode(core.Bech32PrefixConsAddr, core.Bech32PrefixConsPub)
 	config.Seal()
+
+	// keep the compiled wasm code of the simulated chains out of the source tree
+	viper.Set(flags.FlagHome, filepath.Join(os.TempDir(), "terra-simapp"))
 }
 
 // helper function for populating input for SimulateFromSeed
@@ -169,7 +177,8 @@ func appStateRandomizedFn(
 
 	simapp.GenGenesisAccounts(cdc, r, accs, genesisTimestamp, amount, numInitiallyBonded, genesisState)
 	simapp.GenAuthGenesisState(cdc, r, appParams, genesisState)
-	simapp.GenBankGenesisState(cdc, r, appParams, genesisState)
+	// the wasm contracts release their funds through bank sends, so sends are always enabled
+	genesisState[bank.ModuleName] = cdc.MustMarshalJSON(bank.NewGenesisState(true))
 	simapp.GenSupplyGenesisState(cdc, amount, numInitiallyBonded, int64(len(accs)), genesisState)
 	simapp.GenGovGenesisState(cdc, r, appParams, genesisState)
 	simapp.GenDistrGenesisState(cdc, r, appParams, genesisState)
@@ -188,6 +197,11 @@ func testAndRunTxs(app *TerraApp) []simulation.WeightedOperation {
 	cdc := MakeCodec()
 	ap := make(simulation.AppParams)
 
+	wasmCode, err := ioutil.ReadFile("../x/wasm/internal/keeper/testdata/contract.wasm")
+	if err != nil {
+		panic(err)
+	}
+
 	if paramsFile != "" {
 		bz, err := ioutil.ReadFile(paramsFile)
 		if err != nil {
@@ -440,6 +454,39 @@ func testAndRunTxs(app *TerraApp) []simulation.WeightedOperation {
 			}(nil),
 			marketsim.SimulateMsgPrevote(app.marketKeeper),
 		},
+		{
+			Weight: func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgStoreCode, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			Op: wasmsim.SimulateMsgStoreCode(app.wasmKeeper, wasmCode),
+		},
+		{
+			Weight: func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgInstantiateContract, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			Op: wasmsim.SimulateMsgInstantiateContract(app.accountKeeper, app.wasmKeeper),
+		},
+		{
+			Weight: func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgExecuteContract, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			Op: wasmsim.SimulateMsgExecuteContract(app.accountKeeper, app.wasmKeeper),
+		},
 	}
 }
 
@@ -682,6 +729,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[oracle.StoreKey], newApp.keys[oracle.StoreKey], [][]byte{}},
 		{app.keys[treasury.StoreKey], newApp.keys[treasury.StoreKey], [][]byte{}},
 		{app.keys[market.StoreKey], newApp.keys[market.StoreKey], [][]byte{}},
+		{app.keys[wasm.StoreKey], newApp.keys[wasm.StoreKey], [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
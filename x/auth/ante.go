@@ -15,8 +15,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/bank"
+	"github.com/terra-project/core/x/treasury"
+	treasuryexported "github.com/terra-project/core/x/treasury/exported"
 )
 
 var (
@@ -137,8 +138,9 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper,
 				return newCtx, res, true
 			}
 
-			// record tax proceeds
+			// record tax proceeds, and the taxes paid by the fee payer
 			treasuryKeeper.RecordEpochTaxProceeds(newCtx, taxes)
+			treasuryKeeper.RecordTaxPayment(newCtx, signerAccs[0].GetAddress(), taxes)
 
 			// reload the account as fees have been deducted
 			signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
@@ -383,48 +385,27 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc Account, f
 	return sdk.Result{}
 }
 
-// filterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
+// filterMsgAndComputeTax computes the stability tax on MsgSend, MsgMultiSend and
+// the coins transferred by the msgs of other modules implementing treasuryexported.TaxedMsg.
 func filterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs []sdk.Msg) (taxes sdk.Coins) {
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case bank.MsgSend:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Amount))
+			outputs := []bank.Output{bank.NewOutput(msg.ToAddress, msg.Amount)}
+			taxes = taxes.Add(treasury.ComputeTaxFromKeeper(ctx, tk, treasury.FilterTaxExemptCoinsFromKeeper(ctx, tk, msg.FromAddress, outputs, msg.Amount)))
 
 		case bank.MsgMultiSend:
 			for _, input := range msg.Inputs {
-				taxes = taxes.Add(computeTax(ctx, tk, input.Coins))
+				taxes = taxes.Add(treasury.ComputeTaxFromKeeper(ctx, tk, treasury.FilterTaxExemptCoinsFromKeeper(ctx, tk, input.Address, msg.Outputs, input.Coins)))
 			}
-		}
-	}
-
-	return
-}
-
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) (taxes sdk.Coins) {
-	taxRate := tk.GetTaxRate(ctx)
-	if taxRate.Equal(sdk.ZeroDec()) {
-		return
-	}
 
-	for _, coin := range principal {
-		if coin.Denom == core.MicroLunaDenom {
-			continue
+		case treasuryexported.TaxedMsg:
+			// the recipient is empty when it is not known before the msg is handled, e.g. the address
+			// of a contract being instantiated; then only the sender's own exemptions apply
+			sender, recipient, coins := msg.GetTaxedTransfer()
+			outputs := []bank.Output{bank.NewOutput(recipient, coins)}
+			taxes = taxes.Add(treasury.ComputeTaxFromKeeper(ctx, tk, treasury.FilterTaxExemptCoinsFromKeeper(ctx, tk, sender, outputs, coins)))
 		}
-
-		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
-
-		// If tax due is greater than the tax cap, cap!
-		taxCap := tk.GetTaxCap(ctx, coin.Denom)
-		if taxDue.GT(taxCap) {
-			taxDue = taxCap
-		}
-
-		if taxDue.Equal(sdk.ZeroInt()) {
-			continue
-		}
-
-		taxes = taxes.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, taxDue)))
 	}
 
 	return
@@ -829,3 +829,89 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+func TestFilterMsgAndComputeTaxExemptions(t *testing.T) {
+	input := setupTestInput()
+	tk := NewDummyTreasuryKeeper()
+
+	_, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+	_, _, addr3 := types.KeyTestPubAddr()
+
+	amt := sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 100000), sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+	send := bank.MsgSend{FromAddress: addr1, ToAddress: addr2, Amount: amt}
+
+	// no exemption; taxed for every denom
+	taxes := filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{send})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1), sdk.NewInt64Coin(core.MicroSDRDenom, 1)), taxes)
+
+	// pair exemption for ukrw only
+	tk.SetTaxExempt(addr1, addr2, core.MicroKRWDenom)
+	taxes = filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{send})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1)), taxes)
+
+	// multi send; the pair exemption does not cover the transfer to addr3
+	multiSend := bank.MsgMultiSend{
+		Inputs: []bank.Input{bank.NewInput(addr1, amt)},
+		Outputs: []bank.Output{
+			bank.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 50000))),
+			bank.NewOutput(addr3, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 50000), sdk.NewInt64Coin(core.MicroSDRDenom, 100000))),
+		},
+	}
+	taxes = filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{multiSend})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1), sdk.NewInt64Coin(core.MicroSDRDenom, 1)), taxes)
+
+	// sender exemption covers every recipient
+	tk.SetTaxExempt(addr1, nil, core.MicroKRWDenom)
+	tk.SetTaxExempt(addr1, nil, core.MicroSDRDenom)
+	taxes = filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{send, multiSend})
+	require.True(t, taxes.IsZero())
+}
+
+// taxedMsg stands for the msgs of other modules implementing treasuryexported.TaxedMsg
+type taxedMsg struct {
+	sdk.Msg
+	sender, recipient sdk.AccAddress
+	coins             sdk.Coins
+}
+
+func (msg taxedMsg) GetTaxedTransfer() (sender, recipient sdk.AccAddress, coins sdk.Coins) {
+	return msg.sender, msg.recipient, msg.coins
+}
+
+func TestFilterMsgAndComputeTaxTaxedMsg(t *testing.T) {
+	input := setupTestInput()
+	tk := NewDummyTreasuryKeeper()
+
+	_, _, addr1 := types.KeyTestPubAddr()
+	_, _, contract := types.KeyTestPubAddr()
+
+	amt := sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 100000), sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	instantiate := taxedMsg{sender: addr1, coins: amt}
+	execute := taxedMsg{sender: addr1, recipient: contract, coins: amt}
+
+	// luna is not taxed
+	taxes := filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{instantiate, execute})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 2)), taxes)
+
+	// pair exemption applies only to the known recipient
+	tk.SetTaxExempt(addr1, contract, core.MicroKRWDenom)
+	taxes = filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{instantiate, execute})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1)), taxes)
+}
+
+func TestComputeTaxWithTaxRateOverride(t *testing.T) {
+	input := setupTestInput()
+	tk := NewDummyTreasuryKeeper()
+
+	_, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	amt := sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 100000), sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+	send := bank.MsgSend{FromAddress: addr1, ToAddress: addr2, Amount: amt}
+
+	// ukrw is no longer taxed
+	tk.SetTaxRateOverride(core.MicroKRWDenom, sdk.ZeroDec())
+	taxes := filterMsgAndComputeTax(input.ctx, tk, []sdk.Msg{send})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1)), taxes)
+}
@@ -12,9 +12,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
-	core "github.com/terra-project/core/types"
 
 	"github.com/terra-project/core/x/treasury"
+	treasuryexported "github.com/terra-project/core/x/treasury/exported"
 )
 
 type (
@@ -173,9 +173,10 @@ func ComputeFees(
 	return
 }
 
-// filterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
+// filterMsgAndComputeTax computes the stability tax on MsgSend, MsgMultiSend and
+// the coins transferred by the msgs of other modules implementing treasuryexported.TaxedMsg.
 func filterMsgAndComputeTax(cliCtx context.CLIContext, msgs []sdk.Msg) (taxes sdk.Coins, err error) {
-	taxRate, err := queryTaxRate(cliCtx)
+	taxExemptions, err := queryTaxExemptions(cliCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +184,10 @@ func filterMsgAndComputeTax(cliCtx context.CLIContext, msgs []sdk.Msg) (taxes sd
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case bank.MsgSend:
-			tax, err := computeTax(cliCtx, taxRate, msg.Amount)
+			outputs := []bank.Output{bank.NewOutput(msg.ToAddress, msg.Amount)}
+			principal := treasury.FilterTaxExemptCoins(taxExemptions, msg.FromAddress, outputs, msg.Amount)
+
+			tax, err := computeTax(cliCtx, principal)
 			if err != nil {
 				return nil, err
 			}
@@ -192,54 +196,49 @@ func filterMsgAndComputeTax(cliCtx context.CLIContext, msgs []sdk.Msg) (taxes sd
 
 		case bank.MsgMultiSend:
 			for _, input := range msg.Inputs {
-				tax, err := computeTax(cliCtx, taxRate, input.Coins)
+				principal := treasury.FilterTaxExemptCoins(taxExemptions, input.Address, msg.Outputs, input.Coins)
+
+				tax, err := computeTax(cliCtx, principal)
 				if err != nil {
 					return nil, err
 				}
 
 				taxes = taxes.Add(tax)
 			}
-		}
-	}
-
-	return
-}
-
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(cliCtx context.CLIContext, taxRate sdk.Dec, principal sdk.Coins) (taxes sdk.Coins, err error) {
 
-	for _, coin := range principal {
+		case treasuryexported.TaxedMsg:
+			// the recipient is empty when it is not known before the msg is handled, e.g. the address
+			// of a contract being instantiated; then only the sender's own exemptions apply
+			sender, recipient, coins := msg.GetTaxedTransfer()
+			outputs := []bank.Output{bank.NewOutput(recipient, coins)}
+			principal := treasury.FilterTaxExemptCoins(taxExemptions, sender, outputs, coins)
 
-		if coin.Denom == core.MicroLunaDenom {
-			continue
-		}
-
-		taxCap, err := queryTaxCap(cliCtx, coin.Denom)
-		if err != nil {
-			return nil, err
-		}
-
-		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
-
-		// If tax due is greater than the tax cap, cap!
-		if taxDue.GT(taxCap) {
-			taxDue = taxCap
-		}
+			tax, err := computeTax(cliCtx, principal)
+			if err != nil {
+				return nil, err
+			}
 
-		if taxDue.Equal(sdk.ZeroInt()) {
-			continue
+			taxes = taxes.Add(tax)
 		}
-
-		taxes = taxes.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, taxDue)))
 	}
 
 	return
 }
 
-func queryTaxRate(cliCtx context.CLIContext) (sdk.Dec, error) {
+// computes the stability tax with the queried tax-rate and tax-cap of each denom
+func computeTax(cliCtx context.CLIContext, principal sdk.Coins) (sdk.Coins, error) {
+	return treasury.ComputeTax(principal,
+		func(denom string) (sdk.Dec, error) { return queryTaxRate(cliCtx, denom) },
+		func(denom string) (sdk.Int, error) { return queryTaxCap(cliCtx, denom) },
+	)
+}
 
-	// Query tax-rate
-	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate), nil)
+func queryTaxRate(cliCtx context.CLIContext, denom string) (sdk.Dec, error) {
+
+	// Query tax-rate of the denom
+	params := treasury.NewQueryTaxRateParams(denom)
+	bz := cliCtx.Codec.MustMarshalJSON(params)
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate), bz)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -265,6 +264,19 @@ func queryTaxCap(cliCtx context.CLIContext, denom string) (sdk.Int, error) {
 	return taxCap, nil
 }
 
+func queryTaxExemptions(cliCtx context.CLIContext) (treasury.TaxExemptions, error) {
+	// Query tax-exemptions
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxExemptions), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var taxExemptions treasury.TaxExemptions
+	cliCtx.Codec.MustUnmarshalJSON(res, &taxExemptions)
+
+	return taxExemptions, nil
+}
+
 // ParseFloat64 parses string to float64
 func ParseFloat64(s string, defaultIfEmpty float64) (n float64, err error) {
 	if len(s) == 0 {
@@ -7,9 +7,11 @@ import (
 
 // TreasuryKeeper is expected keeper for treasury
 type TreasuryKeeper interface {
-	GetTaxRate(ctx sdk.Context) (rate sdk.Dec)
+	GetDenomTaxRate(ctx sdk.Context, denom string) (rate sdk.Dec)
 	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
 	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
+	RecordTaxPayment(ctx sdk.Context, payer sdk.AccAddress, taxes sdk.Coins)
+	IsTaxExempt(ctx sdk.Context, sender, recipient sdk.AccAddress, denom string) bool
 }
 
 // SupplyKeeper defines the expected supply Keeper (noalias)
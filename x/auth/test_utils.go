@@ -85,13 +85,35 @@ func setupTestInput() testInput {
 }
 
 // DummyTreasuryKeeper no-lint
-type DummyTreasuryKeeper struct{}
+type DummyTreasuryKeeper struct {
+	taxExemptions    map[string]bool
+	taxRateOverrides map[string]sdk.Dec
+}
 
 // NewDummyTreasuryKeeper no-lint
-func NewDummyTreasuryKeeper() DummyTreasuryKeeper { return DummyTreasuryKeeper{} }
+func NewDummyTreasuryKeeper() DummyTreasuryKeeper {
+	return DummyTreasuryKeeper{
+		taxExemptions:    make(map[string]bool),
+		taxRateOverrides: make(map[string]sdk.Dec),
+	}
+}
+
+// SetTaxExempt exempts transfers of denom from sender to recipient (or to anyone when recipient is empty)
+func (tk DummyTreasuryKeeper) SetTaxExempt(sender, recipient sdk.AccAddress, denom string) {
+	tk.taxExemptions[sender.String()+recipient.String()+denom] = true
+}
+
+// SetTaxRateOverride replaces the tax rate of denom
+func (tk DummyTreasuryKeeper) SetTaxRateOverride(denom string, rate sdk.Dec) {
+	tk.taxRateOverrides[denom] = rate
+}
+
+// GetDenomTaxRate for the dummy treasury keeper
+func (tk DummyTreasuryKeeper) GetDenomTaxRate(_ sdk.Context, denom string) (rate sdk.Dec) {
+	if rate, ok := tk.taxRateOverrides[denom]; ok {
+		return rate
+	}
 
-// GetTaxRate for the dummy treasury keeper
-func (tk DummyTreasuryKeeper) GetTaxRate(_ sdk.Context) (rate sdk.Dec) {
 	return sdk.NewDecWithPrec(1, 3) // 0.1%
 }
 
@@ -105,6 +127,16 @@ func (tk DummyTreasuryKeeper) RecordEpochTaxProceeds(_ sdk.Context, _ sdk.Coins)
 	return
 }
 
+// RecordTaxPayment for the dummy treasury keeper
+func (tk DummyTreasuryKeeper) RecordTaxPayment(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	return
+}
+
+// IsTaxExempt for the dummy treasury keeper
+func (tk DummyTreasuryKeeper) IsTaxExempt(_ sdk.Context, sender, recipient sdk.AccAddress, denom string) bool {
+	return tk.taxExemptions[sender.String()+recipient.String()+denom]
+}
+
 // DummySupplyKeeper defines a supply keeper used only for testing to avoid
 // circle dependencies
 type DummySupplyKeeper struct {
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/terra-project/core/x/genutil"
+	v04 "github.com/terra-project/core/x/genutil/legacy/v04"
+)
+
+// migrationCallback migrates the app state of the previous version; unlike
+// genutil.MigrationCallback it returns an error for state it cannot migrate
+type migrationCallback func(genutil.AppMap) (genutil.AppMap, error)
+
+// migrationMap maps each target version to the migration from its previous version
+var migrationMap = map[string]migrationCallback{
+	"v0.4": v04.Migrate,
+}
+
+const (
+	flagGenesisTime = "genesis-time"
+	flagChainID     = "chain-id"
+)
+
+// MigrateGenesisCmd returns a command to migrate the exported genesis of a previous version
+func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
+
+Example:
+$ %s migrate v0.4 /path/to/genesis.json --chain-id=columbus-3 --genesis-time=2019-04-22T17:00:00Z
+`, version.ServerName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := args[0]
+			importGenesis := args[1]
+
+			genDoc, err := types.GenesisDocFromFile(importGenesis)
+			if err != nil {
+				return err
+			}
+
+			var initialState genutil.AppMap
+			cdc.MustUnmarshalJSON(genDoc.AppState, &initialState)
+
+			if migrationMap[target] == nil {
+				return fmt.Errorf("unknown migration function version: %s", target)
+			}
+
+			newGenState, err := migrationMap[target](initialState)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState = cdc.MustMarshalJSON(newGenState)
+
+			genesisTime := cmd.Flag(flagGenesisTime).Value.String()
+			if genesisTime != "" {
+				var t time.Time
+
+				err := t.UnmarshalText([]byte(genesisTime))
+				if err != nil {
+					return err
+				}
+
+				genDoc.GenesisTime = t
+			}
+
+			chainID := cmd.Flag(flagChainID).Value.String()
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			out, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(sdk.MustSortJSON(out)))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag")
+	cmd.Flags().String(flagChainID, "", "Override chain_id with this flag")
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/genutil"
+	"github.com/terra-project/core/x/treasury"
+)
+
+// v0.3 exported treasury state with positional indicators
+const v03TreasuryGenState = `{
+  "params": {
+    "tax_policy": {"rate_min": "0.000500000000000000", "rate_max": "0.010000000000000000", "cap": {"denom": "usdr", "amount": "1000000"}, "change_max": "0.000250000000000000"},
+    "reward_policy": {"rate_min": "0.050000000000000000", "rate_max": "0.900000000000000000", "cap": {"denom": "unused", "amount": "0"}, "change_max": "0.025000000000000000"},
+    "seigniorage_burden_target": "0.670000000000000000",
+    "mining_increment": "1.070000000000000000",
+    "window_short": "4",
+    "window_long": "52",
+    "window_probation": "12"
+  },
+  "tax_rate": "0.001000000000000000",
+  "reward_weight": "0.050000000000000000",
+  "tax_caps": {"ukrw": "1000000"},
+  "tax_proceed": [],
+  "epoch_initial_issuance": [],
+  "TRs": ["0.000000000000000000", "1.000000000000000000", "2.000000000000000000"],
+  "SRs": ["0.000000000000000000", "10.000000000000000000", "20.000000000000000000"],
+  "TSLs": ["100", "200", "300"]
+}`
+
+func TestMigrateGenesis(t *testing.T) {
+	home, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	ctx := server.NewContext(nil, log.NewNopLogger())
+
+	genesisPath := path.Join(home, "genesis.json")
+
+	// Reject if the genesis does not exist
+	require.Error(t, MigrateGenesisCmd(ctx, cdc).RunE(nil, []string{"v0.4", genesisPath}))
+
+	v03Genesis := `{"chain_id":"columbus-2","app_state":{"treasury":` + v03TreasuryGenState + `}}`
+	require.NoError(t, ioutil.WriteFile(genesisPath, []byte(v03Genesis), 0644))
+
+	// Reject an unknown target version
+	cmd := MigrateGenesisCmd(ctx, cdc)
+	cmd.SetArgs([]string{"v0.5", genesisPath})
+	cmd.SetOutput(ioutil.Discard)
+	require.Error(t, cmd.Execute())
+
+	var out bytes.Buffer
+	cmd = MigrateGenesisCmd(ctx, cdc)
+	cmd.SetArgs([]string{"v0.4", genesisPath, "--chain-id=columbus-3"})
+	cmd.SetOutput(&out)
+	require.NoError(t, cmd.Execute())
+
+	var genDoc tmtypes.GenesisDoc
+	require.NoError(t, cdc.UnmarshalJSON(out.Bytes(), &genDoc))
+	require.Equal(t, "columbus-3", genDoc.ChainID)
+
+	var appState genutil.AppMap
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+	var treasuryGenState treasury.GenesisState
+	require.NoError(t, treasury.ModuleCdc.UnmarshalJSON(appState[treasury.ModuleName], &treasuryGenState))
+	require.NoError(t, treasury.ValidateGenesis(treasuryGenState))
+
+	// the positional indicators carry their epochs explicitly
+	require.Equal(t, []treasury.EpochDec{
+		treasury.NewEpochDec(0, sdk.ZeroDec()),
+		treasury.NewEpochDec(1, sdk.NewDec(1)),
+		treasury.NewEpochDec(2, sdk.NewDec(2)),
+	}, treasuryGenState.TRs)
+	require.Equal(t, []treasury.EpochDec{
+		treasury.NewEpochDec(0, sdk.ZeroDec()),
+		treasury.NewEpochDec(1, sdk.NewDec(10)),
+		treasury.NewEpochDec(2, sdk.NewDec(20)),
+	}, treasuryGenState.SRs)
+	require.Equal(t, []treasury.EpochInt{
+		treasury.NewEpochInt(0, sdk.NewInt(100)),
+		treasury.NewEpochInt(1, sdk.NewInt(200)),
+		treasury.NewEpochInt(2, sdk.NewInt(300)),
+	}, treasuryGenState.TSLs)
+
+	require.Equal(t, treasury.DefaultEpochLength, treasuryGenState.Params.EpochLength)
+	require.Equal(t, map[string]sdk.Int{"ukrw": sdk.NewInt(1000000)}, treasuryGenState.TaxCaps)
+}
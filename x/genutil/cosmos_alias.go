@@ -26,4 +26,7 @@ type (
 	GenesisState         = genutil.GenesisState
 	CosmosAppModule      = genutil.AppModule
 	CosmosAppModuleBasic = genutil.AppModuleBasic
+	AppMap               = genutil.AppMap
+	MigrationCallback    = genutil.MigrationCallback
+	MigrationMap         = genutil.MigrationMap
 )
@@ -0,0 +1,49 @@
+package v04
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/terra-project/core/x/genutil"
+	"github.com/terra-project/core/x/treasury"
+	v03treasury "github.com/terra-project/core/x/treasury/legacy/v03"
+	v04treasury "github.com/terra-project/core/x/treasury/legacy/v04"
+	"github.com/terra-project/core/x/wasm"
+	v03wasm "github.com/terra-project/core/x/wasm/legacy/v03"
+	v04wasm "github.com/terra-project/core/x/wasm/legacy/v04"
+)
+
+// Migrate migrates exported state from v0.3 to a v0.4 genesis state.
+func Migrate(appState genutil.AppMap) (genutil.AppMap, error) {
+	v03Codec := codec.New()
+	codec.RegisterCrypto(v03Codec)
+
+	v04Codec := codec.New()
+	codec.RegisterCrypto(v04Codec)
+
+	// migrate treasury state
+	if appState[v03treasury.ModuleName] != nil {
+		var treasuryGenState v03treasury.GenesisState
+		v03Codec.MustUnmarshalJSON(appState[v03treasury.ModuleName], &treasuryGenState)
+
+		delete(appState, v03treasury.ModuleName) // delete old key in case the name changed
+		appState[treasury.ModuleName] = v04Codec.MustMarshalJSON(v04treasury.Migrate(treasuryGenState))
+	}
+
+	// migrate wasm state
+	if appState[v03wasm.ModuleName] != nil {
+		var wasmGenState v03wasm.GenesisState
+		v03Codec.MustUnmarshalJSON(appState[v03wasm.ModuleName], &wasmGenState)
+
+		wasmState, err := v04wasm.Migrate(wasmGenState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate wasm state: %s", err)
+		}
+
+		delete(appState, v03wasm.ModuleName) // delete old key in case the name changed
+		appState[wasm.ModuleName] = v04Codec.MustMarshalJSON(wasmState)
+	}
+
+	return appState, nil
+}
@@ -4,36 +4,32 @@ import (
 	"github.com/terra-project/core/x/treasury/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	core "github.com/terra-project/core/types"
 )
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k Keeper) {
 
 	// Check epoch last block
-	if !core.IsPeriodLastBlock(ctx, core.BlocksPerEpoch) {
+	if !k.IsEpochLastBlock(ctx) {
 		return
 	}
 
+	// Let the other modules act at the epoch boundary first
+	k.BeforeEpochEnd(ctx, k.GetEpoch(ctx))
+
 	// Update luna issuance after finish all works
 	defer k.RecordEpochInitialIssuance(ctx)
 
-	// Compute & Update internal indicators for the current epoch
-	k.UpdateIndicators(ctx)
+	// Apply a changed epoch-length from the next epoch
+	defer k.UpdateEpochBoundary(ctx)
 
-	// Check probation period
-	if ctx.BlockHeight() < (core.BlocksPerEpoch * k.WindowProbation(ctx)) {
+	// Record the indicators and update the policy of the next epoch past the probation period
+	taxRate, rewardWeight, taxCap, updated := k.EndEpoch(ctx)
+	if !updated {
 		return
 	}
 
-	// Settle seiniorage to oracle & distribution(community-pool) module-account
-	k.SettleSeigniorage(ctx)
-
-	// Update tax-rate and reward-weight of next epoch
-	taxRate := k.UpdateTaxPolicy(ctx)
-	rewardWeight := k.UpdateRewardPolicy(ctx)
-	taxCap := k.UpdateTaxCap(ctx)
+	k.AfterPolicyUpdate(ctx, taxRate, rewardWeight, taxCap)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypePolichUpdate,
@@ -42,5 +38,4 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 			sdk.NewAttribute(types.AttributeKeyTaxCap, taxCap.String()),
 		),
 	)
-
 }
@@ -64,3 +64,96 @@ func TestUpdate(t *testing.T) {
 	newRewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
 	require.Equal(t, rewardWeight.Add(input.TreasuryKeeper.RewardPolicy(input.Ctx).ChangeRateMax), newRewardWeight)
 }
+
+func TestEndBlockerEpochLengthUpdate(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	// Set total staked luna to prevent divide by zero error when computing TRL
+	bondedModuleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, stakingtypes.BondedPoolName)
+	err := bondedModuleAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000000000)))
+	require.NoError(t, err)
+	input.SupplyKeeper.SetModuleAccount(input.Ctx, bondedModuleAcc)
+
+	epochLength := core.BlocksPerHour
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.EpochLength = epochLength
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// the current epoch keeps its length
+	input.Ctx = input.Ctx.WithBlockHeight(epochLength - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Equal(t, int64(0), input.TreasuryKeeper.GetEpoch(input.Ctx))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	// policies are updated once the probation ends with the new epoch-length
+	windowProbation := input.TreasuryKeeper.WindowProbation(input.Ctx)
+	for epoch := int64(1); epoch < windowProbation; epoch++ {
+		input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch + epochLength*epoch - 1)
+		require.Equal(t, epoch, input.TreasuryKeeper.GetEpoch(input.Ctx))
+		EndBlocker(input.Ctx, input.TreasuryKeeper)
+	}
+
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch + epochLength*windowProbation - 1)
+	require.Equal(t, windowProbation, input.TreasuryKeeper.GetEpoch(input.Ctx))
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	require.Equal(t, taxRate.Add(input.TreasuryKeeper.TaxPolicy(input.Ctx).ChangeRateMax), input.TreasuryKeeper.GetTaxRate(input.Ctx))
+}
+
+// hooksRecorder records the treasury hooks calls
+type hooksRecorder struct {
+	epochs        []int64
+	taxRates      []sdk.Dec
+	rewardWeights []sdk.Dec
+	taxCaps       []sdk.Coins
+}
+
+func (h *hooksRecorder) BeforeEpochEnd(_ sdk.Context, epoch int64) {
+	h.epochs = append(h.epochs, epoch)
+}
+
+func (h *hooksRecorder) AfterPolicyUpdate(_ sdk.Context, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins) {
+	h.taxRates = append(h.taxRates, taxRate)
+	h.rewardWeights = append(h.rewardWeights, rewardWeight)
+	h.taxCaps = append(h.taxCaps, taxCaps)
+}
+
+func TestEndBlockerHooks(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	first, second := &hooksRecorder{}, &hooksRecorder{}
+	input.TreasuryKeeper.SetHooks(NewMultiTreasuryHooks(first, second))
+	require.Panics(t, func() { input.TreasuryKeeper.SetHooks(NewMultiTreasuryHooks()) })
+
+	// Set total staked luna to prevent divide by zero error when computing TRL
+	bondedModuleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, stakingtypes.BondedPoolName)
+	err := bondedModuleAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000000000)))
+	require.NoError(t, err)
+	input.SupplyKeeper.SetModuleAccount(input.Ctx, bondedModuleAcc)
+
+	// Not the last block of an epoch
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 2)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Empty(t, first.epochs)
+
+	// Within the probation, only BeforeEpochEnd is called
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Equal(t, []int64{0}, first.epochs)
+	require.Empty(t, first.taxRates)
+
+	windowProbation := input.TreasuryKeeper.WindowProbation(input.Ctx)
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch*(windowProbation+1) - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Equal(t, []int64{0, windowProbation}, first.epochs)
+	require.Equal(t, []sdk.Dec{input.TreasuryKeeper.GetTaxRate(input.Ctx)}, first.taxRates)
+	require.Equal(t, []sdk.Dec{input.TreasuryKeeper.GetRewardWeight(input.Ctx)}, first.rewardWeights)
+	require.Equal(t, 1, len(first.taxCaps))
+
+	// every composed hooks is called
+	require.Equal(t, first, second)
+}
@@ -11,42 +11,103 @@ import (
 )
 
 const (
-	DefaultCodespace               = types.DefaultCodespace
-	CodeInvalidEpoch               = types.CodeInvalidEpoch
-	ModuleName                     = types.ModuleName
-	StoreKey                       = types.StoreKey
-	RouterKey                      = types.RouterKey
-	QuerierRoute                   = types.QuerierRoute
-	DefaultParamspace              = types.DefaultParamspace
-	ProposalTypeTaxRateUpdate      = types.ProposalTypeTaxRateUpdate
-	ProposalTypeRewardWeightUpdate = types.ProposalTypeRewardWeightUpdate
-	QueryTaxRate                   = types.QueryTaxRate
-	QueryTaxCap                    = types.QueryTaxCap
-	QueryRewardWeight              = types.QueryRewardWeight
-	QuerySeigniorageProceeds       = types.QuerySeigniorageProceeds
-	QueryTaxProceeds               = types.QueryTaxProceeds
-	QueryParameters                = types.QueryParameters
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidEpoch                  = types.CodeInvalidEpoch
+	ModuleName                        = types.ModuleName
+	StoreKey                          = types.StoreKey
+	RouterKey                         = types.RouterKey
+	QuerierRoute                      = types.QuerierRoute
+	DefaultParamspace                 = types.DefaultParamspace
+	ProposalTypeTaxRateUpdate         = types.ProposalTypeTaxRateUpdate
+	ProposalTypeRewardWeightUpdate    = types.ProposalTypeRewardWeightUpdate
+	QueryTaxRate                      = types.QueryTaxRate
+	QueryTaxCap                       = types.QueryTaxCap
+	QueryRewardWeight                 = types.QueryRewardWeight
+	QuerySeigniorageProceeds          = types.QuerySeigniorageProceeds
+	QueryTaxProceeds                  = types.QueryTaxProceeds
+	QueryParameters                   = types.QueryParameters
+	QueryCurrentEpoch                 = types.QueryCurrentEpoch
+	QueryIndicators                   = types.QueryIndicators
+	QueryProjectPolicy                = types.QueryProjectPolicy
+	CodeInvalidTaxExemption           = types.CodeInvalidTaxExemption
+	CodeNoTaxExemption                = types.CodeNoTaxExemption
+	ProposalTypeTaxExemptionAdd       = types.ProposalTypeTaxExemptionAdd
+	ProposalTypeTaxExemptionRemove    = types.ProposalTypeTaxExemptionRemove
+	QueryTaxExemptions                = types.QueryTaxExemptions
+	ProposalTypeTaxRateOverrideUpdate = types.ProposalTypeTaxRateOverrideUpdate
+	QueryTaxRateOverrides             = types.QueryTaxRateOverrides
+	MinEpochLength                    = types.MinEpochLength
+	QuerySeigniorageSettlement        = types.QuerySeigniorageSettlement
+	ProposalTypeTaxCapUpdate          = types.ProposalTypeTaxCapUpdate
+	QueryTaxCapPins                   = types.QueryTaxCapPins
+	CodeExpiredTaxCapPin              = types.CodeExpiredTaxCapPin
+	QueryTaxPayments                  = types.QueryTaxPayments
+	BurnModuleName                    = types.BurnModuleName
+	CodePrunedEpoch                   = types.CodePrunedEpoch
+	CodeInvalidEpochLength            = types.CodeInvalidEpochLength
+	CodeInvalidSeigniorageRoutes      = types.CodeInvalidSeigniorageRoutes
 )
 
 var (
 	// functions aliases
-	RegisterCodec                 = types.RegisterCodec
-	ErrInvalidEpoch               = types.ErrInvalidEpoch
-	NewGenesisState               = types.NewGenesisState
-	DefaultGenesisState           = types.DefaultGenesisState
-	ValidateGenesis               = types.ValidateGenesis
-	GetTaxCapKey                  = types.GetTaxCapKey
-	GetTRKey                      = types.GetTRKey
-	GetSRKey                      = types.GetSRKey
-	GetTSLKey                     = types.GetTSLKey
-	GetSubkeyByEpoch              = types.GetSubkeyByEpoch
-	DefaultParams                 = types.DefaultParams
-	NewTaxRateUpdateProposal      = types.NewTaxRateUpdateProposal
-	NewRewardWeightUpdateProposal = types.NewRewardWeightUpdateProposal
-	NewQueryTaxCapParams          = types.NewQueryTaxCapParams
-	NewKeeper                     = keeper.NewKeeper
-	ParamKeyTable                 = keeper.ParamKeyTable
-	NewQuerier                    = keeper.NewQuerier
+	RegisterCodec                    = types.RegisterCodec
+	ErrInvalidEpoch                  = types.ErrInvalidEpoch
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	ValidateGenesis                  = types.ValidateGenesis
+	GetTaxCapKey                     = types.GetTaxCapKey
+	GetTRKey                         = types.GetTRKey
+	GetSRKey                         = types.GetSRKey
+	GetTSLKey                        = types.GetTSLKey
+	GetPolicyIndicatorsKey           = types.GetPolicyIndicatorsKey
+	GetSubkeyByEpoch                 = types.GetSubkeyByEpoch
+	DefaultParams                    = types.DefaultParams
+	NewTaxRateUpdateProposal         = types.NewTaxRateUpdateProposal
+	NewRewardWeightUpdateProposal    = types.NewRewardWeightUpdateProposal
+	NewQueryTaxCapParams             = types.NewQueryTaxCapParams
+	NewQueryIndicatorsParams         = types.NewQueryIndicatorsParams
+	NewQueryProjectPolicyParams      = types.NewQueryProjectPolicyParams
+	NewKeeper                        = keeper.NewKeeper
+	ParamKeyTable                    = keeper.ParamKeyTable
+	NewQuerier                       = keeper.NewQuerier
+	ErrInvalidTaxExemption           = types.ErrInvalidTaxExemption
+	ErrNoTaxExemption                = types.ErrNoTaxExemption
+	NewTaxExemption                  = types.NewTaxExemption
+	GetTaxExemptionKey               = types.GetTaxExemptionKey
+	NewTaxExemptionAddProposal       = types.NewTaxExemptionAddProposal
+	NewTaxExemptionRemoveProposal    = types.NewTaxExemptionRemoveProposal
+	NewTaxRateOverride               = types.NewTaxRateOverride
+	NewTaxRateOverrideUpdateProposal = types.NewTaxRateOverrideUpdateProposal
+	GetTaxRateOverrideKey            = types.GetTaxRateOverrideKey
+	NewQueryTaxRateParams            = types.NewQueryTaxRateParams
+	NewEpochBoundary                 = types.NewEpochBoundary
+	GetEpochBoundaryKey              = types.GetEpochBoundaryKey
+	NewSeigniorageRoute              = types.NewSeigniorageRoute
+	NewSeigniorageTransfer           = types.NewSeigniorageTransfer
+	ErrExpiredTaxCapPin              = types.ErrExpiredTaxCapPin
+	NewTaxCapPin                     = types.NewTaxCapPin
+	NewTaxCapUpdateProposal          = types.NewTaxCapUpdateProposal
+	GetTaxCapPinKey                  = types.GetTaxCapPinKey
+	NewEpochDec                      = types.NewEpochDec
+	NewEpochInt                      = types.NewEpochInt
+	NewPolicyIndicators              = types.NewPolicyIndicators
+	NewEpochPolicyIndicators         = types.NewEpochPolicyIndicators
+	GetEpochFromSubkey               = types.GetEpochFromSubkey
+	NewTaxPayment                    = types.NewTaxPayment
+	GetTaxPaymentEpochKey            = types.GetTaxPaymentEpochKey
+	GetTaxPaymentPayerKey            = types.GetTaxPaymentPayerKey
+	GetTaxPaymentKey                 = types.GetTaxPaymentKey
+	ParseTaxPaymentKey               = types.ParseTaxPaymentKey
+	NewQueryTaxPaymentsParams        = types.NewQueryTaxPaymentsParams
+	NewMsgBurn                       = types.NewMsgBurn
+	NewMultiTreasuryHooks            = types.NewMultiTreasuryHooks
+	ErrPrunedEpoch                   = types.ErrPrunedEpoch
+	ComputeTax                       = types.ComputeTax
+	ComputeTaxFromKeeper             = types.ComputeTaxFromKeeper
+	FilterTaxExemptCoins             = types.FilterTaxExemptCoins
+	FilterTaxExemptCoinsFromKeeper   = types.FilterTaxExemptCoinsFromKeeper
+	ErrInvalidEpochLength            = types.ErrInvalidEpochLength
+	ErrInvalidSeigniorageRoutes      = types.ErrInvalidSeigniorageRoutes
 
 	// variable aliases
 	ModuleCdc                            = types.ModuleCdc
@@ -58,6 +119,7 @@ var (
 	TRKey                                = types.TRKey
 	SRKey                                = types.SRKey
 	TSLKey                               = types.TSLKey
+	PolicyIndicatorsKey                  = types.PolicyIndicatorsKey
 	ParamStoreKeyTaxPolicy               = types.ParamStoreKeyTaxPolicy
 	ParamStoreKeyRewardPolicy            = types.ParamStoreKeyRewardPolicy
 	ParamStoreKeySeigniorageBurdenTarget = types.ParamStoreKeySeigniorageBurdenTarget
@@ -74,18 +136,68 @@ var (
 	DefaultWindowProbation               = types.DefaultWindowProbation
 	DefaultTaxRate                       = types.DefaultTaxRate
 	DefaultRewardWeight                  = types.DefaultRewardWeight
+	TaxExemptionKey                      = types.TaxExemptionKey
+	TaxRateOverrideKey                   = types.TaxRateOverrideKey
+	ParamStoreKeyEpochLength             = types.ParamStoreKeyEpochLength
+	DefaultEpochLength                   = types.DefaultEpochLength
+	EpochBoundaryKey                     = types.EpochBoundaryKey
+	ParamStoreKeySeigniorageRoutes       = types.ParamStoreKeySeigniorageRoutes
+	DefaultSeigniorageRoutes             = types.DefaultSeigniorageRoutes
+	SeigniorageSettlementKey             = types.SeigniorageSettlementKey
+	TaxCapPinKey                         = types.TaxCapPinKey
+	ParamStoreKeyIndicatorRetention      = types.ParamStoreKeyIndicatorRetention
+	DefaultIndicatorRetention            = types.DefaultIndicatorRetention
+	ParamStoreKeyTaxPaymentIndex         = types.ParamStoreKeyTaxPaymentIndex
+	DefaultTaxPaymentIndex               = types.DefaultTaxPaymentIndex
+	TaxPaymentKey                        = types.TaxPaymentKey
+	TotalBurnsKey                        = types.TotalBurnsKey
+	EpochBurnsKey                        = types.EpochBurnsKey
 )
 
 type (
-	PolicyConstraints          = types.PolicyConstraints
-	SupplyKeeper               = types.SupplyKeeper
-	MarketKeeper               = types.MarketKeeper
-	StakingKeeper              = types.StakingKeeper
-	DistributionKeeper         = types.DistributionKeeper
-	GenesisState               = types.GenesisState
-	Params                     = types.Params
-	TaxRateUpdateProposal      = types.TaxRateUpdateProposal
-	RewardWeightUpdateProposal = types.RewardWeightUpdateProposal
-	QueryTaxCapParams          = types.QueryTaxCapParams
-	Keeper                     = keeper.Keeper
+	PolicyConstraints             = types.PolicyConstraints
+	SupplyKeeper                  = types.SupplyKeeper
+	MarketKeeper                  = types.MarketKeeper
+	StakingKeeper                 = types.StakingKeeper
+	DistributionKeeper            = types.DistributionKeeper
+	GenesisState                  = types.GenesisState
+	Params                        = types.Params
+	TaxRateUpdateProposal         = types.TaxRateUpdateProposal
+	RewardWeightUpdateProposal    = types.RewardWeightUpdateProposal
+	QueryTaxCapParams             = types.QueryTaxCapParams
+	QueryIndicatorsParams         = types.QueryIndicatorsParams
+	EpochIndicators               = types.EpochIndicators
+	EpochIndicatorsList           = types.EpochIndicatorsList
+	QueryProjectPolicyParams      = types.QueryProjectPolicyParams
+	PolicyProjection              = types.PolicyProjection
+	Keeper                        = keeper.Keeper
+	TaxExemption                  = types.TaxExemption
+	TaxExemptions                 = types.TaxExemptions
+	TaxExemptionAddProposal       = types.TaxExemptionAddProposal
+	TaxExemptionRemoveProposal    = types.TaxExemptionRemoveProposal
+	TaxRateOverride               = types.TaxRateOverride
+	TaxRateOverrides              = types.TaxRateOverrides
+	TaxRateOverrideUpdateProposal = types.TaxRateOverrideUpdateProposal
+	QueryTaxRateParams            = types.QueryTaxRateParams
+	EpochBoundary                 = types.EpochBoundary
+	EpochBoundaries               = types.EpochBoundaries
+	SeigniorageRoute              = types.SeigniorageRoute
+	SeigniorageRoutes             = types.SeigniorageRoutes
+	SeigniorageTransfer           = types.SeigniorageTransfer
+	SeigniorageSettlement         = types.SeigniorageSettlement
+	TaxCapPin                     = types.TaxCapPin
+	TaxCapPins                    = types.TaxCapPins
+	TaxCapUpdateProposal          = types.TaxCapUpdateProposal
+	EpochDec                      = types.EpochDec
+	EpochInt                      = types.EpochInt
+	PolicyIndicators              = types.PolicyIndicators
+	EpochPolicyIndicators         = types.EpochPolicyIndicators
+	TaxPayment                    = types.TaxPayment
+	TaxPayments                   = types.TaxPayments
+	QueryTaxPaymentsParams        = types.QueryTaxPaymentsParams
+	MsgBurn                       = types.MsgBurn
+	TreasuryHooks                 = types.TreasuryHooks
+	MultiTreasuryHooks            = types.MultiTreasuryHooks
+	TaxPolicyKeeper               = types.TaxPolicyKeeper
+	TaxExemptionKeeper            = types.TaxExemptionKeeper
 )
@@ -2,11 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/terra-project/core/x/treasury/internal/types"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,6 +20,11 @@ import (
 const (
 	flagDenom = "denom"
 	flagEpoch = "epoch"
+
+	flagTaxRewards         = "tax-rewards"
+	flagSeigniorageRewards = "seigniorage-rewards"
+	flagTotalStakedLuna    = "total-staked-luna"
+	flagParams             = "params"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -35,7 +43,15 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(cdc),
 		GetCmdQueryTaxProceeds(cdc),
 		GetCmdQuerySeigniorageProceeds(cdc),
-		GetCmdQueryParams(cdc),
+		GetCmdQueryCurrentEpoch(cdc),
+		GetCmdQueryIndicators(cdc),
+		GetCmdQueryProjectPolicy(cdc),
+		GetCmdQueryTaxExemptions(cdc),
+		GetCmdQueryTaxRateOverrides(cdc),
+		GetCmdQuerySeigniorageSettlement(cdc),
+		GetCmdQueryTaxCapPins(cdc),
+		GetCmdQueryTaxPayments(cdc),
+		GetCmdQueryBurns(cdc),
 	)...)
 
 	return oracleQueryCmd
@@ -45,17 +61,28 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 // GetCmdQueryTaxRate implements the query tax-rate command.
 func GetCmdQueryTaxRate(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tax-rate",
-		Args:  cobra.NoArgs,
+		Use:   "tax-rate [denom]",
+		Args:  cobra.RangeArgs(0, 1),
 		Short: "Query the stability tax rate",
 		Long: strings.TrimSpace(`
 Query the stability tax rate of the current epoch.
 
 $ terracli query treasury tax-rate
+
+Query the stability tax rate applied to a denom asset, which is the tax rate override of the denom if exists.
+
+$ terracli query treasury tax-rate ukrw
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRate), nil)
+
+			var bz []byte
+			if len(args) == 1 {
+				params := types.NewQueryTaxRateParams(args[0])
+				bz = cdc.MustMarshalJSON(params)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRate), bz)
 			if err != nil {
 				return err
 			}
@@ -78,6 +105,7 @@ func GetCmdQueryTaxCap(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(`
 Query the current stability tax cap of the denom asset. 
 The stability tax levied on a tx is at most tax cap, regardless of the size of the transaction. 
+A tax cap pinned by governance takes precedence over the tax cap converted from the tax policy.
 
 $ terracli query treasury tax-cap ukrw
 `),
@@ -157,6 +185,33 @@ $ terracli query treasury tax-proceeds
 	return cmd
 }
 
+// GetCmdQueryBurns implements the query burns command.
+func GetCmdQueryBurns(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burns",
+		Args:  cobra.NoArgs,
+		Short: "Query the cumulative voluntary burns",
+		Long: strings.TrimSpace(`
+Query the cumulative amount of each denom burned with burn transactions. Burns through market swaps are not included.
+
+$ terracli query treasury burns
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBurns), nil)
+			if err != nil {
+				return err
+			}
+
+			var burns sdk.Coins
+			cdc.MustUnmarshalJSON(res, &burns)
+			return cliCtx.PrintOutput(burns)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdQuerySeigniorageProceeds implements the query seigniorage-proceeds command.
 func GetCmdQuerySeigniorageProceeds(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -184,6 +239,341 @@ $ terracli query treasury seigniorage-proceeds
 	return cmd
 }
 
+// GetCmdQueryCurrentEpoch implements the query current-epoch command.
+func GetCmdQueryCurrentEpoch(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch",
+		Args:  cobra.NoArgs,
+		Short: "Query the current epoch number",
+		Long: strings.TrimSpace(`
+Query the current epoch, starting from 0.
+
+$ terracli query treasury current-epoch
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+			if err != nil {
+				return err
+			}
+
+			var curEpoch int64
+			cdc.MustUnmarshalJSON(res, &curEpoch)
+			return cliCtx.PrintOutput(sdk.NewInt(curEpoch))
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryIndicators implements the query indicators command.
+func GetCmdQueryIndicators(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indicators [start-epoch] [end-epoch]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Query the treasury indicators over an epoch range",
+		Long: strings.TrimSpace(`
+Query the treasury indicators (TR, SR, TSL, MR, TRL) recorded for each epoch in [start-epoch, end-epoch], 
+together with the rolling averages and sums the tax-rate and reward-weight updates used at that epoch,
+which are left out for epochs without a policy update.
+If end-epoch is omitted, only start-epoch is queried. If both are omitted, the current epoch is queried.
+
+$ terracli query treasury indicators 10 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+			if err != nil {
+				return err
+			}
+
+			var startEpoch int64
+			cdc.MustUnmarshalJSON(res, &startEpoch)
+
+			if len(args) > 0 {
+				startEpoch, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			endEpoch := startEpoch
+			if len(args) > 1 {
+				endEpoch, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryIndicatorsParams(startEpoch, endEpoch)
+			bz := cdc.MustMarshalJSON(params)
+
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryIndicators), bz)
+			if err != nil {
+				return err
+			}
+
+			var indicators types.EpochIndicatorsList
+			cdc.MustUnmarshalJSON(res, &indicators)
+			return cliCtx.PrintOutput(indicators)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryProjectPolicy implements the query project-policy command.
+func GetCmdQueryProjectPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-policy",
+		Args:  cobra.NoArgs,
+		Short: "Project the tax rate, reward weight and tax caps of the next epoch",
+		Long: strings.TrimSpace(`
+Project the tax rate, reward weight and tax caps which the treasury will set at the end of the current epoch.
+The policy updates are run on the current state without committing anything. Hypothetical values for the 
+current epoch indicators and a hypothetical set of treasury params (JSON file, same format as the params query) 
+can be supplied to see how they would change the outcome.
+
+$ terracli query treasury project-policy
+$ terracli query treasury project-policy --tax-rewards 1000000000 --total-staked-luna 500000000000000
+$ terracli query treasury project-policy --params <path/to/params.json>
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var params types.QueryProjectPolicyParams
+
+			if paramsFile := viper.GetString(flagParams); len(paramsFile) != 0 {
+				contents, err := ioutil.ReadFile(paramsFile)
+				if err != nil {
+					return err
+				}
+
+				var treasuryParams types.Params
+				if err := cdc.UnmarshalJSON(contents, &treasuryParams); err != nil {
+					return err
+				}
+
+				params.Params = &treasuryParams
+			}
+
+			if trStr := viper.GetString(flagTaxRewards); len(trStr) != 0 {
+				TR, err := sdk.NewDecFromStr(trStr)
+				if err != nil {
+					return err
+				}
+
+				params.TR = &TR
+			}
+
+			if srStr := viper.GetString(flagSeigniorageRewards); len(srStr) != 0 {
+				SR, err := sdk.NewDecFromStr(srStr)
+				if err != nil {
+					return err
+				}
+
+				params.SR = &SR
+			}
+
+			if tslStr := viper.GetString(flagTotalStakedLuna); len(tslStr) != 0 {
+				TSL, ok := sdk.NewIntFromString(tslStr)
+				if !ok {
+					return fmt.Errorf("invalid total staked luna: %s", tslStr)
+				}
+
+				params.TSL = &TSL
+			}
+
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProjectPolicy), bz)
+			if err != nil {
+				return err
+			}
+
+			var projection types.PolicyProjection
+			cdc.MustUnmarshalJSON(res, &projection)
+			return cliCtx.PrintOutput(projection)
+		},
+	}
+
+	cmd.Flags().String(flagTaxRewards, "", "hypothetical tax rewards (TR) of the current epoch in usdr")
+	cmd.Flags().String(flagSeigniorageRewards, "", "hypothetical seigniorage rewards (SR) of the current epoch in usdr")
+	cmd.Flags().String(flagTotalStakedLuna, "", "hypothetical total staked luna (TSL) of the current epoch in uluna")
+	cmd.Flags().String(flagParams, "", "path to a JSON file with hypothetical treasury params")
+
+	return cmd
+}
+
+// GetCmdQueryTaxExemptions implements the query tax-exemptions command.
+func GetCmdQueryTaxExemptions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemptions",
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax exemptions",
+		Long: strings.TrimSpace(`
+Query all registered stability tax exemptions. 
+
+$ terracli query treasury tax-exemptions
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxExemptions), nil)
+			if err != nil {
+				return err
+			}
+
+			var exemptions types.TaxExemptions
+			cdc.MustUnmarshalJSON(res, &exemptions)
+			return cliCtx.PrintOutput(exemptions)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTaxRateOverrides implements the query tax-rate-overrides command.
+func GetCmdQueryTaxRateOverrides(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-rate-overrides",
+		Args:  cobra.NoArgs,
+		Short: "Query the per-denom stability tax rates",
+		Long: strings.TrimSpace(`
+Query all tax rate overrides. A denom without override is taxed at the global tax rate.
+
+$ terracli query treasury tax-rate-overrides
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRateOverrides), nil)
+			if err != nil {
+				return err
+			}
+
+			var overrides types.TaxRateOverrides
+			cdc.MustUnmarshalJSON(res, &overrides)
+			return cliCtx.PrintOutput(overrides)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySeigniorageSettlement implements the query seigniorage-settlement command.
+func GetCmdQuerySeigniorageSettlement(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seigniorage-settlement",
+		Args:  cobra.NoArgs,
+		Short: "Query the breakdown of the last seigniorage settlement",
+		Long: strings.TrimSpace(`
+Query the seigniorage minted at the end of the last settled epoch and its transfers to the oracle and the seigniorage routes.
+
+$ terracli query treasury seigniorage-settlement
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySeigniorageSettlement), nil)
+			if err != nil {
+				return err
+			}
+
+			var settlement types.SeigniorageSettlement
+			cdc.MustUnmarshalJSON(res, &settlement)
+			return cliCtx.PrintOutput(settlement)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTaxCapPins implements the query tax-cap-pins command.
+func GetCmdQueryTaxCapPins(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-cap-pins",
+		Args:  cobra.NoArgs,
+		Short: "Query the tax caps pinned by governance",
+		Long: strings.TrimSpace(`
+Query all tax cap pins. A pinned tax cap replaces the tax cap converted from the tax policy until its expiry epoch.
+
+$ terracli query treasury tax-cap-pins
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxCapPins), nil)
+			if err != nil {
+				return err
+			}
+
+			var pins types.TaxCapPins
+			cdc.MustUnmarshalJSON(res, &pins)
+			return cliCtx.PrintOutput(pins)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTaxPayments implements the query tax-payments command.
+func GetCmdQueryTaxPayments(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-payments [payer] [start-epoch] [end-epoch]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Query the stability tax paid by an address over an epoch range",
+		Long: strings.TrimSpace(`
+Query the stability tax paid by the payer in each epoch in [start-epoch, end-epoch]. Epochs without any tax paid are omitted.
+If end-epoch is omitted, only start-epoch is queried. If both are omitted, the current epoch is queried.
+Tax payments are only recorded while the tax_payment_index param is enabled, and are pruned with the indicators.
+
+$ terracli query treasury tax-payments terra1... 10 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			payer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+			if err != nil {
+				return err
+			}
+
+			var startEpoch int64
+			cdc.MustUnmarshalJSON(res, &startEpoch)
+
+			if len(args) > 1 {
+				startEpoch, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			endEpoch := startEpoch
+			if len(args) > 2 {
+				endEpoch, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryTaxPaymentsParams(payer, startEpoch, endEpoch)
+			bz := cdc.MustMarshalJSON(params)
+
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxPayments), bz)
+			if err != nil {
+				return err
+			}
+
+			var payments types.TaxPayments
+			cdc.MustUnmarshalJSON(res, &payments)
+			return cliCtx.PrintOutput(payments)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,60 @@ import (
 	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	treasuryTxCmd := &cobra.Command{
+		Use:                        "treasury",
+		Short:                      "Treasury transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	treasuryTxCmd.AddCommand(client.PostCommands(
+		GetBurnCmd(cdc),
+	)...)
+
+	return treasuryTxCmd
+}
+
+// GetBurnCmd will create and send a MsgBurn
+func GetBurnCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Voluntarily burn coins",
+		Long: strings.TrimSpace(`
+Burn the coins from the sender account. The burned coins are tracked per denom,
+and voluntary Luna burns are not counted as seigniorage.
+
+$ terracli tx treasury burn "1000000uluna"
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgBurn(fromAddress, amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdSubmitTaxRateUpdateProposal implements the command to submit a tax-rate-update proposal
 func GetCmdSubmitTaxRateUpdateProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -124,3 +179,243 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitTaxExemptionAddProposal implements the command to submit a tax-exemption-add proposal
+func GetCmdSubmitTaxExemptionAddProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemption-add [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a tax exemption add proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a tax exemption add proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+An exemption without counterparty exempts every transfer sent from the address;
+with a counterparty, only transfers between the two addresses are exempt.
+An empty denoms list exempts every denom.
+
+Example:
+$ %s tx gov submit-proposal tax-exemption-add <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Exempt exchange wallets",
+  "description": "Lets exempt transfers between the hot and cold wallets of the exchange",
+  "exemptions": [
+    {
+      "address": "terra1...",
+      "counterparty": "terra1...",
+      "denoms": ["ukrw", "usdr"]
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseTaxExemptionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTaxExemptionAddProposal(proposal.Title, proposal.Description, proposal.Exemptions)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitTaxExemptionRemoveProposal implements the command to submit a tax-exemption-remove proposal
+func GetCmdSubmitTaxExemptionRemoveProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemption-remove [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a tax exemption remove proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a tax exemption remove proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal tax-exemption-remove <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Remove exchange wallets exemption",
+  "description": "Lets remove the exemption of the exchange wallets",
+  "exemptions": [
+    {
+      "address": "terra1...",
+      "counterparty": "terra1..."
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseTaxExemptionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTaxExemptionRemoveProposal(proposal.Title, proposal.Description, proposal.Exemptions)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitTaxRateOverrideUpdateProposal implements the command to submit a tax-rate-override-update proposal
+func GetCmdSubmitTaxRateOverrideUpdateProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-rate-override-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a tax rate override update proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a tax rate override update proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+The tax rate of the denom replaces the global tax rate, and is clamped by the tax policy
+in the same way as a tax rate update.
+
+Example:
+$ %s tx gov submit-proposal tax-rate-override-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update KRT Tax Rate",
+  "description": "Lets update tax rate of KRT to 0.5%%",
+  "denom": "ukrw",
+  "tax_rate": "0.005",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseTaxRateOverrideUpdateProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTaxRateOverrideUpdateProposal(proposal.Title, proposal.Description, proposal.Denom, proposal.TaxRate)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitTaxCapUpdateProposal implements the command to submit a tax-cap-update proposal
+func GetCmdSubmitTaxCapUpdateProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-cap-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a tax cap update proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a tax cap update proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+The tax cap of the denom is pinned, replacing the tax cap converted from the tax policy,
+up to and including the expiry epoch. An expiry epoch of 0 pins the tax cap until replaced.
+
+Example:
+$ %s tx gov submit-proposal tax-cap-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update KRT Tax Cap",
+  "description": "Lets pin tax cap of KRT to 1500krw",
+  "denom": "ukrw",
+  "tax_cap": "1500000000",
+  "expiry_epoch": "52",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseTaxCapUpdateProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTaxCapUpdateProposal(proposal.Title, proposal.Description, proposal.Denom, proposal.TaxCap, proposal.ExpiryEpoch)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
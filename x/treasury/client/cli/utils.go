@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
 type (
@@ -24,6 +26,33 @@ type (
 		RewardWeight sdk.Dec   `json:"tax_rate" yaml:"tax_rate"`
 		Deposit      sdk.Coins `json:"deposit" yaml:"deposit"`
 	}
+
+	// TaxExemptionProposalJSON defines a TaxExemptionAddProposal or TaxExemptionRemoveProposal with a deposit
+	TaxExemptionProposalJSON struct {
+		Title       string              `json:"title" yaml:"title"`
+		Description string              `json:"description" yaml:"description"`
+		Exemptions  types.TaxExemptions `json:"exemptions" yaml:"exemptions"`
+		Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// TaxRateOverrideUpdateProposalJSON defines a TaxRateOverrideUpdateProposal with a deposit
+	TaxRateOverrideUpdateProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Denom       string    `json:"denom" yaml:"denom"`
+		TaxRate     sdk.Dec   `json:"tax_rate" yaml:"tax_rate"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// TaxCapUpdateProposalJSON defines a TaxCapUpdateProposal with a deposit
+	TaxCapUpdateProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Denom       string    `json:"denom" yaml:"denom"`
+		TaxCap      sdk.Int   `json:"tax_cap" yaml:"tax_cap"`
+		ExpiryEpoch int64     `json:"expiry_epoch" yaml:"expiry_epoch"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseTaxRateUpdateProposalJSON reads and parses a TaxRateUpdateProposalJSON from a file.
@@ -57,3 +86,51 @@ func ParseRewardWeightUpdateProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseTaxExemptionProposalJSON reads and parses a TaxExemptionProposalJSON from a file.
+func ParseTaxExemptionProposalJSON(cdc *codec.Codec, proposalFile string) (TaxExemptionProposalJSON, error) {
+	proposal := TaxExemptionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseTaxRateOverrideUpdateProposalJSON reads and parses a TaxRateOverrideUpdateProposalJSON from a file.
+func ParseTaxRateOverrideUpdateProposalJSON(cdc *codec.Codec, proposalFile string) (TaxRateOverrideUpdateProposalJSON, error) {
+	proposal := TaxRateOverrideUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseTaxCapUpdateProposalJSON reads and parses a TaxCapUpdateProposalJSON from a file.
+func ParseTaxCapUpdateProposalJSON(cdc *codec.Codec, proposalFile string) (TaxCapUpdateProposalJSON, error) {
+	proposal := TaxCapUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
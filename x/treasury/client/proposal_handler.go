@@ -8,6 +8,10 @@ import (
 
 // param change proposal handler
 var (
-	TaxRateUpdateProposalHandler      = govclient.NewProposalHandler(cli.GetCmdSubmitTaxRateUpdateProposal, rest.TaxRateUpdateProposalRESTHandler)
-	RewardWeightUpdateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRewardWeightUpdateProposal, rest.RewardWeightUpdateProposalRESTHandler)
+	TaxRateUpdateProposalHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitTaxRateUpdateProposal, rest.TaxRateUpdateProposalRESTHandler)
+	RewardWeightUpdateProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitRewardWeightUpdateProposal, rest.RewardWeightUpdateProposalRESTHandler)
+	TaxExemptionAddProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitTaxExemptionAddProposal, rest.TaxExemptionAddProposalRESTHandler)
+	TaxExemptionRemoveProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitTaxExemptionRemoveProposal, rest.TaxExemptionRemoveProposalRESTHandler)
+	TaxRateOverrideUpdateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTaxRateOverrideUpdateProposal, rest.TaxRateOverrideUpdateProposalRESTHandler)
+	TaxCapUpdateProposalHandler          = govclient.NewProposalHandler(cli.GetCmdSubmitTaxCapUpdateProposal, rest.TaxCapUpdateProposalRESTHandler)
 )
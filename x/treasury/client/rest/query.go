@@ -3,21 +3,35 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/terra-project/core/x/treasury/internal/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 )
 
 func registerQueryRoute(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/treasury/tax_rate", queryTaxRateHandlerFunction(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/tax_rate/{%s}", RestDenom), queryTaxRateHandlerFunction(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/tax_cap/{%s}", RestDenom), queryTaxCapHandlerFunction(cliCtx)).Methods("GET")
 	r.HandleFunc("/treasury/reward_weight", queryRewardWeightHandlerFunction(cliCtx)).Methods("GET")
 	r.HandleFunc("/treasury/tax_proceeds", queryTaxProceedsHandlerFunction(cliCtx)).Methods("GET")
 	r.HandleFunc("/treasury/seigniorage_proceeds", querySeigniorageProceedsHandlerFunction(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/burns", queryBurnsHandlerFunction(cliCtx)).Methods("GET")
 	r.HandleFunc("/treasury/parameters", queryParametersHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/current_epoch", queryCurrentEpochHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/indicators", queryIndicatorsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/indicators/{%s}", RestEpoch), queryIndicatorsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/project_policy", queryProjectPolicyHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/project_policy", postProjectPolicyHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/tax_exemptions", queryTaxExemptionsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/tax_rate_overrides", queryTaxRateOverridesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/seigniorage_settlement", querySeigniorageSettlementHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/treasury/tax_cap_pins", queryTaxCapPinsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/tax_payments/{%s}", RestPayer), queryTaxPaymentsHandlerFn(cliCtx)).Methods("GET")
 }
 
 func queryTaxRateHandlerFunction(cliCtx context.CLIContext) http.HandlerFunc {
@@ -27,7 +41,13 @@ func queryTaxRateHandlerFunction(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRate), nil)
+		var bz []byte
+		if denom := mux.Vars(r)[RestDenom]; len(denom) != 0 {
+			params := types.NewQueryTaxRateParams(denom)
+			bz = cliCtx.Codec.MustMarshalJSON(params)
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRate), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -98,6 +118,24 @@ func queryTaxProceedsHandlerFunction(cliCtx context.CLIContext) http.HandlerFunc
 	}
 }
 
+func queryBurnsHandlerFunction(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBurns), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func querySeigniorageProceedsHandlerFunction(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -116,6 +154,78 @@ func querySeigniorageProceedsHandlerFunction(cliCtx context.CLIContext) http.Han
 	}
 }
 
+func queryTaxExemptionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxExemptions), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTaxRateOverridesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxRateOverrides), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func querySeigniorageSettlementHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySeigniorageSettlement), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTaxCapPinsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxCapPins), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryParametersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -133,3 +243,226 @@ func queryParametersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryCurrentEpochHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryIndicatorsHandlerFn serves the indicators of a single epoch when the epoch is given
+// in the path, or of the [start_epoch, end_epoch] range given as query parameters
+func queryIndicatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		err := r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				sdk.AppendMsgToErr("could not parse query parameters", err.Error()))
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var startEpoch int64
+		cliCtx.Codec.MustUnmarshalJSON(res, &startEpoch)
+
+		startEpochStr := r.Form.Get("start_epoch")
+		if epochStr, ok := mux.Vars(r)[RestEpoch]; ok {
+			startEpochStr = epochStr
+		}
+
+		if len(startEpochStr) != 0 {
+			startEpoch, err = strconv.ParseInt(startEpochStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		endEpoch := startEpoch
+		if endEpochStr := r.Form.Get("end_epoch"); len(endEpochStr) != 0 {
+			endEpoch, err = strconv.ParseInt(endEpochStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryIndicatorsParams(startEpoch, endEpoch)
+		bz := cliCtx.Codec.MustMarshalJSON(params)
+
+		res, height, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryIndicators), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryProjectPolicyHandlerFn projects the next policy with optional hypothetical
+// indicators given as the TR, SR and TSL query parameters
+func queryProjectPolicyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		err := r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				sdk.AppendMsgToErr("could not parse query parameters", err.Error()))
+			return
+		}
+
+		var params types.QueryProjectPolicyParams
+
+		if trStr := r.Form.Get("TR"); len(trStr) != 0 {
+			TR, err := sdk.NewDecFromStr(trStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			params.TR = &TR
+		}
+
+		if srStr := r.Form.Get("SR"); len(srStr) != 0 {
+			SR, err := sdk.NewDecFromStr(srStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			params.SR = &SR
+		}
+
+		if tslStr := r.Form.Get("TSL"); len(tslStr) != 0 {
+			TSL, ok := sdk.NewIntFromString(tslStr)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid TSL: %s", tslStr))
+				return
+			}
+
+			params.TSL = &TSL
+		}
+
+		queryProjectPolicy(w, cliCtx, params)
+	}
+}
+
+// postProjectPolicyHandlerFn projects the next policy with hypothetical
+// indicators and params given in the request body
+func postProjectPolicyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var params types.QueryProjectPolicyParams
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &params) {
+			return
+		}
+
+		queryProjectPolicy(w, cliCtx, params)
+	}
+}
+
+func queryProjectPolicy(w http.ResponseWriter, cliCtx context.CLIContext, params types.QueryProjectPolicyParams) {
+	bz := cliCtx.Codec.MustMarshalJSON(params)
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProjectPolicy), bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
+// queryTaxPaymentsHandlerFn serves the tax payments of the payer over the
+// [start_epoch, end_epoch] range given as query parameters
+func queryTaxPaymentsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		payer, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestPayer])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				sdk.AppendMsgToErr("could not parse query parameters", err.Error()))
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var startEpoch int64
+		cliCtx.Codec.MustUnmarshalJSON(res, &startEpoch)
+
+		if startEpochStr := r.Form.Get("start_epoch"); len(startEpochStr) != 0 {
+			startEpoch, err = strconv.ParseInt(startEpochStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		endEpoch := startEpoch
+		if endEpochStr := r.Form.Get("end_epoch"); len(endEpochStr) != 0 {
+			endEpoch, err = strconv.ParseInt(endEpochStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryTaxPaymentsParams(payer, startEpoch, endEpoch)
+		bz := cliCtx.Codec.MustMarshalJSON(params)
+
+		res, height, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaxPayments), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
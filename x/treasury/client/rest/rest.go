@@ -11,11 +11,12 @@ import (
 const (
 	RestDenom = "denom"
 	RestEpoch = "epoch"
+	RestPayer = "payer"
 )
 
 // RegisterRoutes registers oracle-related REST handlers to a router
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// resgisterTxRoute(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
 	registerQueryRoute(cliCtx, r)
 }
 
@@ -34,3 +35,35 @@ func RewardWeightUpdateProposalRESTHandler(cliCtx context.CLIContext) govrest.Pr
 		Handler:  postRewardWeightUpdateProposalHandlerFn(cliCtx),
 	}
 }
+
+// TaxExemptionAddProposalRESTHandler returns a ProposalRESTHandler that exposes the tax exemption add REST handler with a given sub-route.
+func TaxExemptionAddProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "tax_exemption_add",
+		Handler:  postTaxExemptionAddProposalHandlerFn(cliCtx),
+	}
+}
+
+// TaxExemptionRemoveProposalRESTHandler returns a ProposalRESTHandler that exposes the tax exemption remove REST handler with a given sub-route.
+func TaxExemptionRemoveProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "tax_exemption_remove",
+		Handler:  postTaxExemptionRemoveProposalHandlerFn(cliCtx),
+	}
+}
+
+// TaxRateOverrideUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the tax rate override update REST handler with a given sub-route.
+func TaxRateOverrideUpdateProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "tax_rate_override_update",
+		Handler:  postTaxRateOverrideUpdateProposalHandlerFn(cliCtx),
+	}
+}
+
+// TaxCapUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the tax cap update REST handler with a given sub-route.
+func TaxCapUpdateProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "tax_cap_update",
+		Handler:  postTaxCapUpdateProposalHandlerFn(cliCtx),
+	}
+}
@@ -3,9 +3,18 @@ package rest
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
 type (
+	// BurnReq defines request body for a voluntary burn
+	BurnReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Amount sdk.Coins `json:"amount" yaml:"amount"`
+	}
+
 	// TaxRateUpdateProposalReq defines a tax-rate-update proposal request body.
 	TaxRateUpdateProposalReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -27,4 +36,40 @@ type (
 		Proposer     sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// TaxExemptionProposalReq defines a tax-exemption-add or tax-exemption-remove proposal request body.
+	TaxExemptionProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string              `json:"title" yaml:"title"`
+		Description string              `json:"description" yaml:"description"`
+		Exemptions  types.TaxExemptions `json:"exemptions" yaml:"exemptions"`
+		Proposer    sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// TaxRateOverrideUpdateProposalReq defines a tax-rate-override-update proposal request body.
+	TaxRateOverrideUpdateProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Denom       string         `json:"denom" yaml:"denom"`
+		TaxRate     sdk.Dec        `json:"tax_rate" yaml:"tax_rate"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// TaxCapUpdateProposalReq defines a tax-cap-update proposal request body.
+	TaxCapUpdateProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Denom       string         `json:"denom" yaml:"denom"`
+		TaxCap      sdk.Int        `json:"tax_cap" yaml:"tax_cap"`
+		ExpiryEpoch int64          `json:"expiry_epoch" yaml:"expiry_epoch"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -0,0 +1,13 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TaxedMsg is implemented by the msgs of other modules which transfer coins out of the
+// sender's account, so they pay the stability tax the same way as the bank sends do.
+// The recipient is empty when it is not known before the msg is handled.
+type TaxedMsg interface {
+	sdk.Msg
+	GetTaxedTransfer() (sender, recipient sdk.AccAddress, coins sdk.Coins)
+}
@@ -1,8 +1,9 @@
 package treasury
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	core "github.com/terra-project/core/types"
 )
 
 // InitGenesis initializes default parameters
@@ -13,6 +14,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetTaxRate(ctx, data.TaxRate)
 	keeper.SetRewardWeight(ctx, data.RewardWeight)
 	keeper.SetEpochTaxProceeds(ctx, data.TaxProceed)
+	keeper.SetTotalBurns(ctx, data.TotalBurns)
+	keeper.SetEpochBurns(ctx, data.EpochBurns)
 
 	// If EpochInitialIssuance is empty, we use current supply as epoch initial issuance
 	if data.EpochInitialIssuance.IsZero() {
@@ -26,14 +29,42 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.SetTaxCap(ctx, denom, taxCap)
 	}
 
-	for epoch, TR := range data.TRs {
-		keeper.SetTR(ctx, int64(epoch), TR)
+	for _, exemption := range data.TaxExemptions {
+		keeper.SetTaxExemption(ctx, exemption)
+	}
+
+	for _, pin := range data.TaxCapPins {
+		keeper.SetTaxCapPin(ctx, pin)
+	}
+
+	for _, override := range data.TaxRateOverrides {
+		keeper.SetTaxRateOverride(ctx, override.Denom, override.TaxRate)
+	}
+
+	// Without a recorded history, epochs are counted with the epoch-length param from genesis
+	if len(data.EpochBoundaries) == 0 {
+		keeper.SetEpochBoundary(ctx, NewEpochBoundary(0, 0, data.Params.EpochLength))
+	}
+
+	for _, boundary := range data.EpochBoundaries {
+		keeper.SetEpochBoundary(ctx, boundary)
+	}
+
+	for _, TR := range data.TRs {
+		keeper.SetTR(ctx, TR.Epoch, TR.Amount)
+	}
+	for _, SR := range data.SRs {
+		keeper.SetSR(ctx, SR.Epoch, SR.Amount)
+	}
+	for _, TSL := range data.TSLs {
+		keeper.SetTSL(ctx, TSL.Epoch, TSL.Amount)
 	}
-	for epoch, SR := range data.SRs {
-		keeper.SetSR(ctx, int64(epoch), SR)
+	for _, indicators := range data.PolicyIndicators {
+		keeper.SetPolicyIndicators(ctx, indicators.Epoch, indicators.Indicators)
 	}
-	for epoch, TSL := range data.TSLs {
-		keeper.SetTSL(ctx, int64(epoch), TSL)
+
+	for _, payment := range data.TaxPayments {
+		keeper.SetTaxPayment(ctx, payment)
 	}
 }
 
@@ -54,19 +85,44 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
-	var TRs []sdk.Dec
-	var SRs []sdk.Dec
-	var TSLs []sdk.Int
+	taxExemptions := keeper.GetTaxExemptions(ctx)
+	taxRateOverrides := keeper.GetTaxRateOverrides(ctx)
+	epochBoundaries := keeper.GetEpochBoundaries(ctx)
+	taxCapPins := keeper.GetTaxCapPins(ctx)
+	taxPayments := keeper.GetTaxPayments(ctx)
+	totalBurns := keeper.GetTotalBurns(ctx)
+	epochBurns := keeper.GetEpochBurns(ctx)
 
-	curEpoch := core.GetEpoch(ctx)
-	for e := int64(0); e < curEpoch ||
-		(e == curEpoch && core.IsPeriodLastBlock(ctx, core.BlocksPerEpoch)); e++ {
+	TRs := []EpochDec{}
+	keeper.IterateTRs(ctx, func(epoch int64, TR sdk.Dec) bool {
+		TRs = append(TRs, NewEpochDec(epoch, TR))
+		return false
+	})
 
-		TRs = append(TRs, keeper.GetTR(ctx, e))
-		SRs = append(SRs, keeper.GetSR(ctx, e))
-		TSLs = append(TSLs, keeper.GetTSL(ctx, e))
-	}
+	SRs := []EpochDec{}
+	keeper.IterateSRs(ctx, func(epoch int64, SR sdk.Dec) bool {
+		SRs = append(SRs, NewEpochDec(epoch, SR))
+		return false
+	})
+
+	TSLs := []EpochInt{}
+	keeper.IterateTSLs(ctx, func(epoch int64, TSL sdk.Int) bool {
+		TSLs = append(TSLs, NewEpochInt(epoch, TSL))
+		return false
+	})
+
+	policyIndicators := []EpochPolicyIndicators{}
+	keeper.IteratePolicyIndicators(ctx, func(epoch int64, indicators PolicyIndicators) bool {
+		policyIndicators = append(policyIndicators, NewEpochPolicyIndicators(epoch, indicators))
+		return false
+	})
+
+	// indicator keys are not in epoch order, so sort them for a readable export
+	sort.Slice(TRs, func(i, j int) bool { return TRs[i].Epoch < TRs[j].Epoch })
+	sort.Slice(SRs, func(i, j int) bool { return SRs[i].Epoch < SRs[j].Epoch })
+	sort.Slice(TSLs, func(i, j int) bool { return TSLs[i].Epoch < TSLs[j].Epoch })
+	sort.Slice(policyIndicators, func(i, j int) bool { return policyIndicators[i].Epoch < policyIndicators[j].Epoch })
 
 	return NewGenesisState(params, taxRate, rewardWeight,
-		taxCaps, taxProceeds, epochInitialIssuance, TRs, SRs, TSLs)
+		taxCaps, taxProceeds, epochInitialIssuance, TRs, SRs, TSLs, policyIndicators, taxExemptions, taxRateOverrides, epochBoundaries, taxCapPins, taxPayments, totalBurns, epochBurns)
 }
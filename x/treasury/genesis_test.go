@@ -25,6 +25,10 @@ func TestExportInitGenesis(t *testing.T) {
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(0), sdk.NewInt(123))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(1), sdk.NewInt(345))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(2), sdk.NewInt(567))
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, NewTaxCapPin("bar", sdk.NewInt(890), 3))
+	input.TreasuryKeeper.SetTaxPayment(input.Ctx, NewTaxPayment(1, keeper.Addrs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 12), sdk.NewInt64Coin("bar", 34))))
+	input.TreasuryKeeper.SetTaxPayment(input.Ctx, NewTaxPayment(1, keeper.Addrs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 56))))
+	input.TreasuryKeeper.SetTotalBurns(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 78)))
 	genesis := ExportGenesis(input.Ctx, input.TreasuryKeeper)
 
 	newInput := keeper.CreateTestInput(t)
@@ -32,6 +36,12 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := ExportGenesis(newInput.Ctx, newInput.TreasuryKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Equal(t, []EpochDec{
+		NewEpochDec(0, sdk.NewDec(123)),
+		NewEpochDec(1, sdk.NewDec(345)),
+		NewEpochDec(2, sdk.NewDec(567)),
+	}, genesis.TRs)
+	require.Equal(t, 2, len(genesis.TaxPayments))
 
 	// Make epoch initial issuance to zero
 	tmp := genesis.EpochInitialIssuance
@@ -2,11 +2,53 @@ package treasury
 
 import (
 	"fmt"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-project/core/x/oracle"
+	"github.com/terra-project/core/x/params"
+	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
+// NewHandler creates a new handler for all treasury type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgBurn:
+			return handleMsgBurn(ctx, k, msg)
+		default:
+			errMsg := "Unrecognized treasury Msg type: " + reflect.TypeOf(msg).Name()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// handleMsgBurn handles the logic of a MsgBurn
+func handleMsgBurn(ctx sdk.Context, k Keeper, msg MsgBurn) sdk.Result {
+	err := k.Burn(ctx, msg.Burner, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurner, msg.Burner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // NewTreasuryPolicyUpdateHandler custom gov proposal handler
 func NewTreasuryPolicyUpdateHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
@@ -15,6 +57,14 @@ func NewTreasuryPolicyUpdateHandler(k Keeper) govtypes.Handler {
 			return handleTaxRateUpdateProposal(ctx, k, c)
 		case RewardWeightUpdateProposal:
 			return handleRewardWeightUpdateProposal(ctx, k, c)
+		case TaxExemptionAddProposal:
+			return handleTaxExemptionAddProposal(ctx, k, c)
+		case TaxExemptionRemoveProposal:
+			return handleTaxExemptionRemoveProposal(ctx, k, c)
+		case TaxRateOverrideUpdateProposal:
+			return handleTaxRateOverrideUpdateProposal(ctx, k, c)
+		case TaxCapUpdateProposal:
+			return handleTaxCapUpdateProposal(ctx, k, c)
 
 		default:
 			errMsg := fmt.Sprintf("unrecognized distr proposal content type: %T", c)
@@ -50,3 +100,124 @@ func handleRewardWeightUpdateProposal(ctx sdk.Context, k Keeper, p RewardWeightU
 	logger.Info(fmt.Sprintf("updated reward-weight to %s", newRewardWeight))
 	return nil
 }
+
+// handleTaxExemptionAddProposal is a handler for registering tax exemptions
+func handleTaxExemptionAddProposal(ctx sdk.Context, k Keeper, p TaxExemptionAddProposal) sdk.Error {
+	logger := k.Logger(ctx)
+	for _, exemption := range p.Exemptions {
+		k.SetTaxExemption(ctx, exemption)
+		logger.Info(fmt.Sprintf("added tax exemption for %s %s", exemption.Address, exemption.Counterparty))
+	}
+
+	return nil
+}
+
+// handleTaxExemptionRemoveProposal is a handler for removing tax exemptions
+func handleTaxExemptionRemoveProposal(ctx sdk.Context, k Keeper, p TaxExemptionRemoveProposal) sdk.Error {
+	for _, exemption := range p.Exemptions {
+		if _, found := k.GetTaxExemption(ctx, exemption.Address, exemption.Counterparty); !found {
+			return ErrNoTaxExemption(k.Codespace(), exemption.Address, exemption.Counterparty)
+		}
+	}
+
+	logger := k.Logger(ctx)
+	for _, exemption := range p.Exemptions {
+		k.DeleteTaxExemption(ctx, exemption.Address, exemption.Counterparty)
+		logger.Info(fmt.Sprintf("removed tax exemption for %s %s", exemption.Address, exemption.Counterparty))
+	}
+
+	return nil
+}
+
+// handleTaxRateOverrideUpdateProposal is a handler for updating the tax-rate override of a denom
+func handleTaxRateOverrideUpdateProposal(ctx sdk.Context, k Keeper, p TaxRateOverrideUpdateProposal) sdk.Error {
+	taxPolicy := k.TaxPolicy(ctx)
+	taxRate := k.GetDenomTaxRate(ctx, p.Denom)
+	newTaxRate := taxPolicy.Clamp(taxRate, p.TaxRate)
+
+	// Set the new tax rate override to the store
+	k.SetTaxRateOverride(ctx, p.Denom, newTaxRate)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("updated tax-rate of %s to %s", p.Denom, newTaxRate))
+	return nil
+}
+
+// handleTaxCapUpdateProposal is a handler for pinning the tax-cap of a denom
+func handleTaxCapUpdateProposal(ctx sdk.Context, k Keeper, p TaxCapUpdateProposal) sdk.Error {
+	curEpoch := k.GetEpoch(ctx)
+	if p.ExpiryEpoch != 0 && p.ExpiryEpoch < curEpoch {
+		return ErrExpiredTaxCapPin(k.Codespace(), curEpoch, p.ExpiryEpoch)
+	}
+
+	// Set the new tax cap pin to the store
+	k.SetTaxCapPin(ctx, NewTaxCapPin(p.Denom, p.TaxCap, p.ExpiryEpoch))
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("pinned tax-cap of %s to %s until epoch %d", p.Denom, p.TaxCap, p.ExpiryEpoch))
+	return nil
+}
+
+// NewParamChangeProposalHandler wraps the params change proposal handler to reject the
+// epoch-length and oracle vote period changes which leave an epoch-length not spanning
+// whole vote periods, and the seigniorage routes changes which leave invalid routes
+func NewParamChangeProposalHandler(k Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		c, ok := content.(params.ParameterChangeProposal)
+		if !ok {
+			return paramsHandler(ctx, content)
+		}
+
+		checkEpochLength := changesEpochLength(c)
+		checkRoutes := changesSeigniorageRoutes(c)
+		if !checkEpochLength && !checkRoutes {
+			return paramsHandler(ctx, content)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+
+		if checkEpochLength {
+			if err := k.ValidateEpochLength(cacheCtx, k.EpochLength(cacheCtx)); err != nil {
+				return err
+			}
+		}
+
+		if checkRoutes {
+			if err := k.SeigniorageRoutes(cacheCtx).Validate(); err != nil {
+				return ErrInvalidSeigniorageRoutes(k.Codespace(), err.Error())
+			}
+		}
+
+		write()
+		return nil
+	}
+}
+
+// changesEpochLength returns true if the proposal changes the epoch-length or the oracle vote period
+func changesEpochLength(p params.ParameterChangeProposal) bool {
+	for _, change := range p.Changes {
+		if change.Subspace == DefaultParamspace && change.Key == string(ParamStoreKeyEpochLength) {
+			return true
+		}
+
+		if change.Subspace == oracle.DefaultParamspace && change.Key == string(oracle.ParamStoreKeyVotePeriod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changesSeigniorageRoutes returns true if the proposal changes the seigniorage routes
+func changesSeigniorageRoutes(p params.ParameterChangeProposal) bool {
+	for _, change := range p.Changes {
+		if change.Subspace == DefaultParamspace && change.Key == string(ParamStoreKeySeigniorageRoutes) {
+			return true
+		}
+	}
+
+	return false
+}
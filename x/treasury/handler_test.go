@@ -0,0 +1,41 @@
+package treasury
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/keeper"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+func TestBurnMsg(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	h := NewHandler(input.TreasuryKeeper)
+
+	// non-treasury message fails
+	res := h(input.Ctx, bank.MsgSend{})
+	require.False(t, res.IsOK())
+
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	res = h(input.Ctx, NewMsgBurn(keeper.Addrs[0], burnCoins))
+	require.True(t, res.IsOK())
+	require.Equal(t, burnCoins, input.TreasuryKeeper.GetTotalBurns(input.Ctx))
+
+	var burnEvent sdk.Event
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeBurn {
+			burnEvent = event
+		}
+	}
+	require.Equal(t, 2, len(burnEvent.Attributes))
+	require.Equal(t, burnCoins.String(), string(burnEvent.Attributes[1].Value))
+
+	// burning more than the balance fails
+	res = h(input.Ctx, NewMsgBurn(keeper.Addrs[0], keeper.InitCoins))
+	require.False(t, res.IsOK())
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// Burn moves the coins from the burner into the burn module account and burns them.
+// Burns are tracked separately from the supply changes of swaps, so voluntary Luna burns
+// are not counted as seigniorage.
+func (k Keeper) Burn(ctx sdk.Context, burner sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, burner, types.BurnModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.supplyKeeper.BurnCoins(ctx, types.BurnModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	k.SetTotalBurns(ctx, k.GetTotalBurns(ctx).Add(amount))
+	k.SetEpochBurns(ctx, k.GetEpochBurns(ctx).Add(amount))
+
+	return nil
+}
+
+// SetTotalBurns stores the cumulative voluntary burns
+func (k Keeper) SetTotalBurns(ctx sdk.Context, burns sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	if burns.IsZero() {
+		store.Delete(types.TotalBurnsKey)
+	} else {
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(burns)
+		store.Set(types.TotalBurnsKey, bz)
+	}
+}
+
+// GetTotalBurns returns the cumulative voluntary burns
+func (k Keeper) GetTotalBurns(ctx sdk.Context) (res sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalBurnsKey)
+	if bz == nil {
+		res = sdk.Coins{}
+	} else {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	}
+	return
+}
+
+// SetEpochBurns stores the voluntary burns of the current epoch
+func (k Keeper) SetEpochBurns(ctx sdk.Context, burns sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	if burns.IsZero() {
+		store.Delete(types.EpochBurnsKey)
+	} else {
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(burns)
+		store.Set(types.EpochBurnsKey, bz)
+	}
+}
+
+// GetEpochBurns returns the voluntary burns of the current epoch
+func (k Keeper) GetEpochBurns(ctx sdk.Context) (res sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochBurnsKey)
+	if bz == nil {
+		res = sdk.Coins{}
+	} else {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestBurn(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+
+	supply := input.SupplyKeeper.GetSupply(input.Ctx).GetTotal()
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+
+	err := input.TreasuryKeeper.Burn(input.Ctx, Addrs[0], burnCoins)
+	require.NoError(t, err)
+	require.Equal(t, supply.Sub(burnCoins), input.SupplyKeeper.GetSupply(input.Ctx).GetTotal())
+
+	err = input.TreasuryKeeper.Burn(input.Ctx, Addrs[0], burnCoins)
+	require.NoError(t, err)
+	require.Equal(t, burnCoins.Add(burnCoins), input.TreasuryKeeper.GetTotalBurns(input.Ctx))
+	require.Equal(t, burnCoins.Add(burnCoins), input.TreasuryKeeper.GetEpochBurns(input.Ctx))
+
+	// voluntary burns are not seigniorage
+	require.True(t, input.TreasuryKeeper.PeekEpochSeigniorage(input.Ctx).IsZero())
+
+	// epoch burns restart with the epoch initial issuance
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+	require.True(t, input.TreasuryKeeper.GetEpochBurns(input.Ctx).Empty())
+	require.Equal(t, burnCoins.Add(burnCoins), input.TreasuryKeeper.GetTotalBurns(input.Ctx))
+
+	// insufficient funds
+	err = input.TreasuryKeeper.Burn(input.Ctx, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000)))
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// SetEpochBoundary stores the epoch boundary
+func (k Keeper) SetEpochBoundary(ctx sdk.Context, boundary types.EpochBoundary) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(boundary)
+	store.Set(types.GetEpochBoundaryKey(boundary.Epoch), bz)
+}
+
+// IterateEpochBoundaries iterates all epoch boundaries in epoch order
+func (k Keeper) IterateEpochBoundaries(ctx sdk.Context, handler func(boundary types.EpochBoundary) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochBoundaryKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var boundary types.EpochBoundary
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &boundary)
+
+		if handler(boundary) {
+			break
+		}
+	}
+}
+
+// GetEpochBoundaries returns the epoch boundary history
+func (k Keeper) GetEpochBoundaries(ctx sdk.Context) (boundaries types.EpochBoundaries) {
+	boundaries = types.EpochBoundaries{}
+	k.IterateEpochBoundaries(ctx, func(boundary types.EpochBoundary) bool {
+		boundaries = append(boundaries, boundary)
+		return false
+	})
+
+	return
+}
+
+// ClearEpochBoundaries deletes the epoch boundary history
+func (k Keeper) ClearEpochBoundaries(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochBoundaryKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// GetEpochBoundary returns the latest epoch boundary at or below the given height.
+// Without a recorded history, epochs follow the legacy fixed core.BlocksPerEpoch.
+func (k Keeper) GetEpochBoundary(ctx sdk.Context, height int64) (boundary types.EpochBoundary) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.EpochBoundaryKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &boundary)
+		if boundary.StartHeight <= height {
+			return
+		}
+	}
+
+	return types.NewEpochBoundary(0, 0, core.BlocksPerEpoch)
+}
+
+// GetEpoch returns the current epoch, starting from 0
+func (k Keeper) GetEpoch(ctx sdk.Context) int64 {
+	height := ctx.BlockHeight()
+	return k.GetEpochBoundary(ctx, height).GetEpoch(height)
+}
+
+// IsEpochLastBlock returns true if we are at the last block of the current epoch
+func (k Keeper) IsEpochLastBlock(ctx sdk.Context) bool {
+	height := ctx.BlockHeight()
+	return k.GetEpochBoundary(ctx, height).IsEpochLastBlock(height)
+}
+
+// ValidateEpochLength checks the epoch-length is at least types.MinEpochLength and
+// a multiple of the oracle vote period
+func (k Keeper) ValidateEpochLength(ctx sdk.Context, epochLength int64) sdk.Error {
+	votePeriod := k.oracleKeeper.VotePeriod(ctx)
+	if epochLength < types.MinEpochLength || votePeriod <= 0 || epochLength%votePeriod != 0 {
+		return types.ErrInvalidEpochLength(k.codespace, epochLength, votePeriod)
+	}
+
+	return nil
+}
+
+// UpdateEpochBoundary records a new epoch boundary from the next block when the
+// epoch-length param differs from the length of the current epoch. Must be called
+// at the last block of an epoch; an invalid epoch-length is ignored.
+func (k Keeper) UpdateEpochBoundary(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	boundary := k.GetEpochBoundary(ctx, height)
+
+	// Params changed by governance are not validated against the oracle vote period
+	// when the vote period changes; keep the current epoch-length
+	epochLength := k.EpochLength(ctx)
+	if epochLength == boundary.EpochLength || k.ValidateEpochLength(ctx, epochLength) != nil {
+		return
+	}
+
+	k.SetEpochBoundary(ctx, types.NewEpochBoundary(boundary.GetEpoch(height)+1, height+1, epochLength))
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+func TestEpochBoundary(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 2)
+	require.Equal(t, int64(0), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.False(t, input.TreasuryKeeper.IsEpochLastBlock(input.Ctx))
+
+	// unchanged epoch-length records nothing
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 1)
+	require.True(t, input.TreasuryKeeper.IsEpochLastBlock(input.Ctx))
+	input.TreasuryKeeper.UpdateEpochBoundary(input.Ctx)
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetEpochBoundaries(input.Ctx)))
+
+	// epoch-length below the minimum is ignored
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.EpochLength = types.MinEpochLength - 1
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+	input.TreasuryKeeper.UpdateEpochBoundary(input.Ctx)
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetEpochBoundaries(input.Ctx)))
+
+	// epoch-length not spanning whole oracle vote periods is ignored
+	params.EpochLength = input.OracleKeeper.VotePeriod(input.Ctx)*20 + 1
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+	input.TreasuryKeeper.UpdateEpochBoundary(input.Ctx)
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetEpochBoundaries(input.Ctx)))
+
+	// changed epoch-length takes effect from the next epoch
+	epochLength := core.BlocksPerHour
+	params.EpochLength = epochLength
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+	input.TreasuryKeeper.UpdateEpochBoundary(input.Ctx)
+	require.Equal(t, types.EpochBoundaries{
+		types.NewEpochBoundary(0, 0, core.BlocksPerEpoch),
+		types.NewEpochBoundary(1, core.BlocksPerEpoch, epochLength),
+	}, input.TreasuryKeeper.GetEpochBoundaries(input.Ctx))
+
+	// past epochs keep their length
+	require.Equal(t, int64(0), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.True(t, input.TreasuryKeeper.IsEpochLastBlock(input.Ctx))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	require.Equal(t, int64(1), input.TreasuryKeeper.GetEpoch(input.Ctx))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch + epochLength - 1)
+	require.Equal(t, int64(1), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.True(t, input.TreasuryKeeper.IsEpochLastBlock(input.Ctx))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch + epochLength*3)
+	require.Equal(t, int64(4), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.False(t, input.TreasuryKeeper.IsEpochLastBlock(input.Ctx))
+}
+
+func TestValidateEpochLength(t *testing.T) {
+	input := CreateTestInput(t)
+
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	require.NoError(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, core.BlocksPerEpoch))
+	require.NoError(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, types.MinEpochLength))
+	require.Error(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, types.MinEpochLength-votePeriod))
+	require.Error(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, core.BlocksPerEpoch+1))
+
+	// the epoch-length must span at least a vote period
+	oracleParams := input.OracleKeeper.GetParams(input.Ctx)
+	oracleParams.VotePeriod = core.BlocksPerHour
+	input.OracleKeeper.SetParams(input.Ctx, oracleParams)
+	require.Error(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, types.MinEpochLength))
+	require.NoError(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, core.BlocksPerHour))
+	require.NoError(t, input.TreasuryKeeper.ValidateEpochLength(input.Ctx, core.BlocksPerDay))
+}
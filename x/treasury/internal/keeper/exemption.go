@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// SetTaxExemption stores the tax exemption; an existing exemption for the
+// same address (pair) is replaced
+func (k Keeper) SetTaxExemption(ctx sdk.Context, exemption types.TaxExemption) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(exemption)
+	store.Set(exemption.Key(), bz)
+}
+
+// GetTaxExemption returns the tax exemption registered for the address (pair)
+func (k Keeper) GetTaxExemption(ctx sdk.Context, address, counterparty sdk.AccAddress) (exemption types.TaxExemption, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxExemptionKey(address, counterparty))
+	if bz == nil {
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &exemption)
+	return exemption, true
+}
+
+// DeleteTaxExemption removes the tax exemption registered for the address (pair)
+func (k Keeper) DeleteTaxExemption(ctx sdk.Context, address, counterparty sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTaxExemptionKey(address, counterparty))
+}
+
+// IterateTaxExemptions iterates all tax exemptions
+func (k Keeper) IterateTaxExemptions(ctx sdk.Context, handler func(exemption types.TaxExemption) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxExemptionKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var exemption types.TaxExemption
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &exemption)
+
+		if handler(exemption) {
+			break
+		}
+	}
+}
+
+// GetTaxExemptions returns all tax exemptions
+func (k Keeper) GetTaxExemptions(ctx sdk.Context) (exemptions types.TaxExemptions) {
+	exemptions = types.TaxExemptions{}
+	k.IterateTaxExemptions(ctx, func(exemption types.TaxExemption) bool {
+		exemptions = append(exemptions, exemption)
+		return false
+	})
+
+	return
+}
+
+// IsTaxExempt returns true if a transfer of denom from sender to recipient is exempt from the stability tax.
+// recipient can be empty to check only the exemptions registered for the sender alone.
+func (k Keeper) IsTaxExempt(ctx sdk.Context, sender, recipient sdk.AccAddress, denom string) bool {
+	if exemption, found := k.GetTaxExemption(ctx, sender, nil); found && exemption.Covers(denom) {
+		return true
+	}
+
+	if recipient.Empty() {
+		return false
+	}
+
+	exemption, found := k.GetTaxExemption(ctx, sender, recipient)
+	return found && exemption.Covers(denom)
+}
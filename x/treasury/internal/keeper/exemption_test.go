@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+func TestTaxExemption(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0], nil, core.MicroSDRDenom))
+
+	// sender exemption restricted to a denom
+	input.TreasuryKeeper.SetTaxExemption(input.Ctx, types.NewTaxExemption(Addrs[0], nil, []string{core.MicroSDRDenom}))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0], nil, core.MicroSDRDenom))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0], Addrs[1], core.MicroSDRDenom))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0], Addrs[1], core.MicroKRWDenom))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[1], Addrs[0], core.MicroSDRDenom))
+
+	// pair exemption applies in both directions
+	input.TreasuryKeeper.SetTaxExemption(input.Ctx, types.NewTaxExemption(Addrs[2], Addrs[1], nil))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[1], Addrs[2], core.MicroKRWDenom))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[2], Addrs[1], core.MicroKRWDenom))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[2], nil, core.MicroKRWDenom))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[2], Addrs[0], core.MicroKRWDenom))
+
+	exemption, found := input.TreasuryKeeper.GetTaxExemption(input.Ctx, Addrs[1], Addrs[2])
+	require.True(t, found)
+	require.Equal(t, Addrs[2], exemption.Address)
+
+	require.Equal(t, 2, len(input.TreasuryKeeper.GetTaxExemptions(input.Ctx)))
+
+	input.TreasuryKeeper.DeleteTaxExemption(input.Ctx, Addrs[1], Addrs[2])
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[1], Addrs[2], core.MicroKRWDenom))
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetTaxExemptions(input.Ctx)))
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// Implements TreasuryHooks interface
+var _ types.TreasuryHooks = Keeper{}
+
+// BeforeEpochEnd - call hook if registered
+func (k Keeper) BeforeEpochEnd(ctx sdk.Context, epoch int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochEnd(ctx, epoch)
+	}
+}
+
+// AfterPolicyUpdate - call hook if registered
+func (k Keeper) AfterPolicyUpdate(ctx sdk.Context, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterPolicyUpdate(ctx, taxRate, rewardWeight, taxCaps)
+	}
+}
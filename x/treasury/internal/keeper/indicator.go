@@ -2,6 +2,7 @@ package keeper
 
 import (
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -36,7 +37,7 @@ func (k Keeper) alignCoins(ctx sdk.Context, coins sdk.DecCoins, denom string) (a
 
 // UpdateIndicators updates interal indicators
 func (k Keeper) UpdateIndicators(ctx sdk.Context) {
-	epoch := core.GetEpoch(ctx)
+	epoch := k.GetEpoch(ctx)
 
 	// Compute Total Staked Luna (TSL)
 	totalStakedLuna := k.stakingKeeper.TotalBondedTokens(ctx)
@@ -63,7 +64,14 @@ func (k Keeper) UpdateIndicators(ctx sdk.Context) {
 
 // TRL returns Tax Rewards per Luna for the epoch
 func TRL(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec {
-	return k.GetTR(ctx, epoch).QuoInt(k.GetTSL(ctx, epoch))
+	TSL := k.GetTSL(ctx, epoch)
+
+	// No staked luna recorded for the epoch; avoid division by zero
+	if TSL.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return k.GetTR(ctx, epoch).QuoInt(TSL)
 }
 
 // SR returns Seigniorage Rewards for the epoch
@@ -79,11 +87,16 @@ func MR(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec {
 // sumIndicator returns the sum of the indicator over several epochs.
 // If current epoch < epochs, we return the best we can and return sumIndicator(currentEpoch)
 func (k Keeper) sumIndicator(ctx sdk.Context, epochs int64,
+	indicator func(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec) sdk.Dec {
+	return k.sumIndicatorAt(ctx, k.GetEpoch(ctx), epochs, indicator)
+}
+
+// sumIndicatorAt returns the sum of the indicator over several epochs ending at the given epoch.
+func (k Keeper) sumIndicatorAt(ctx sdk.Context, epoch int64, epochs int64,
 	indicator func(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec) sdk.Dec {
 	sum := sdk.ZeroDec()
-	curEpoch := core.GetEpoch(ctx)
 
-	for i := curEpoch; i >= 0 && i > (curEpoch-epochs); i-- {
+	for i := epoch; i >= 0 && i > (epoch-epochs); i-- {
 		val := indicator(ctx, i, k)
 		sum = sum.Add(val)
 	}
@@ -94,20 +107,87 @@ func (k Keeper) sumIndicator(ctx sdk.Context, epochs int64,
 // rollingAverageIndicator returns the rolling average of the indicator over several epochs.
 // If current epoch < epochs, we return the best we can and return rollingAverageIndicator(currentEpoch)
 func (k Keeper) rollingAverageIndicator(ctx sdk.Context, epochs int64,
+	indicator func(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec) sdk.Dec {
+	return k.rollingAverageIndicatorAt(ctx, k.GetEpoch(ctx), epochs, indicator)
+}
+
+// rollingAverageIndicatorAt returns the rolling average of the indicator over several epochs ending at the given epoch.
+func (k Keeper) rollingAverageIndicatorAt(ctx sdk.Context, epoch int64, epochs int64,
 	indicator func(ctx sdk.Context, epoch int64, k Keeper) sdk.Dec) sdk.Dec {
 	sum := sdk.ZeroDec()
-	curEpoch := core.GetEpoch(ctx)
 
 	var i int64
-	for i = curEpoch; i >= 0 && i > (curEpoch-epochs); i-- {
+	for i = epoch; i >= 0 && i > (epoch-epochs); i-- {
 		val := indicator(ctx, i, k)
 		sum = sum.Add(val)
 	}
 
-	computedEpochs := curEpoch - i
+	computedEpochs := epoch - i
 	if computedEpochs == 0 {
 		return sum
 	}
 
 	return sum.QuoInt64(computedEpochs)
 }
+
+// GetEpochIndicators returns the recorded indicators of the epoch together with
+// the rolling values the tax and reward policy updates of the epoch computed from them
+func (k Keeper) GetEpochIndicators(ctx sdk.Context, epoch int64) types.EpochIndicators {
+	indicators := types.EpochIndicators{
+		Epoch: epoch,
+		TR:    k.GetTR(ctx, epoch),
+		SR:    k.GetSR(ctx, epoch),
+		TSL:   k.GetTSL(ctx, epoch),
+		MR:    MR(ctx, epoch, k),
+		TRL:   TRL(ctx, epoch, k),
+	}
+
+	if policyIndicators, found := k.GetPolicyIndicators(ctx, epoch); found {
+		indicators.Policy = &policyIndicators
+	}
+
+	return indicators
+}
+
+// GetIndicatorCutoffEpoch returns the first epoch whose indicators are retained
+func (k Keeper) GetIndicatorCutoffEpoch(ctx sdk.Context) int64 {
+	// Params changed by governance are not validated; never prune the indicators
+	// the tax and reward policy updates still read
+	retention := k.IndicatorRetention(ctx)
+	if windowLong := k.WindowLong(ctx); retention < windowLong {
+		retention = windowLong
+	}
+	if retention < 1 {
+		retention = 1
+	}
+
+	return k.GetEpoch(ctx) - retention + 1
+}
+
+// PruneIndicators deletes the indicators, and the tax payments which follow the same
+// retention, recorded before the last IndicatorRetention epochs, and at least WindowLong epochs
+func (k Keeper) PruneIndicators(ctx sdk.Context) {
+	cutoffEpoch := k.GetIndicatorCutoffEpoch(ctx)
+	if cutoffEpoch <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.TRKey, types.SRKey, types.TSLKey, types.PolicyIndicatorsKey} {
+		// Epochs are little endian encoded, so the keys are not in epoch order
+		var prunedKeys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			if types.GetEpochFromSubkey(iter.Key()) < cutoffEpoch {
+				prunedKeys = append(prunedKeys, iter.Key())
+			}
+		}
+		iter.Close()
+
+		for _, key := range prunedKeys {
+			store.Delete(key)
+		}
+	}
+
+	k.PruneTaxPayments(ctx, cutoffEpoch)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -148,6 +149,9 @@ func TestLoadIndicatorByEpoch(t *testing.T) {
 		require.Equal(t, SRArr[epoch], SR(input.Ctx, epoch, input.TreasuryKeeper))
 		require.Equal(t, TRArr[epoch].Add(SRArr[epoch]), MR(input.Ctx, epoch, input.TreasuryKeeper))
 	}
+
+	// No staked luna recorded
+	require.Equal(t, sdk.ZeroDec(), TRL(input.Ctx, 4, input.TreasuryKeeper))
 }
 
 func linearFn(_ sdk.Context, _ Keeper, epoch int64) sdk.Dec {
@@ -235,3 +239,67 @@ func TestRollingAverageIndicator(t *testing.T) {
 	rval = input.TreasuryKeeper.rollingAverageIndicator(input.Ctx, 1, SR)
 	require.Equal(t, sdk.NewDec(400), rval)
 }
+
+func TestPruneIndicators(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.IndicatorRetention = params.WindowLong
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	lastEpoch := params.WindowLong + 10
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+		input.TreasuryKeeper.SetSR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+		input.TreasuryKeeper.SetTSL(input.Ctx, epoch, sdk.NewInt(epoch+1))
+		input.TreasuryKeeper.SetTaxPayment(input.Ctx, types.NewTaxPayment(epoch, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, epoch+1))))
+	}
+
+	// Within the retention window nothing is pruned
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * (params.WindowLong - 1))
+	input.TreasuryKeeper.PruneIndicators(input.Ctx)
+	require.Equal(t, sdk.NewDec(1), input.TreasuryKeeper.GetTR(input.Ctx, 0))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * lastEpoch)
+	input.TreasuryKeeper.PruneIndicators(input.Ctx)
+
+	cutoffEpoch := lastEpoch - params.IndicatorRetention + 1
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		if epoch < cutoffEpoch {
+			require.True(t, input.TreasuryKeeper.GetTR(input.Ctx, epoch).IsZero())
+			require.True(t, input.TreasuryKeeper.GetSR(input.Ctx, epoch).IsZero())
+			require.True(t, input.TreasuryKeeper.GetTSL(input.Ctx, epoch).IsZero())
+			require.True(t, input.TreasuryKeeper.GetTaxPayment(input.Ctx, epoch, Addrs[0]).Empty())
+		} else {
+			require.Equal(t, sdk.NewDec(epoch+1), input.TreasuryKeeper.GetTR(input.Ctx, epoch))
+			require.Equal(t, sdk.NewDec(epoch+1), input.TreasuryKeeper.GetSR(input.Ctx, epoch))
+			require.Equal(t, sdk.NewInt(epoch+1), input.TreasuryKeeper.GetTSL(input.Ctx, epoch))
+			require.False(t, input.TreasuryKeeper.GetTaxPayment(input.Ctx, epoch, Addrs[0]).Empty())
+		}
+	}
+}
+
+func TestPruneIndicatorsInvalidRetention(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// governance may set the retention below the policy windows without validation
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.IndicatorRetention = 0
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	lastEpoch := params.WindowLong + 10
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+	}
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * lastEpoch)
+	input.TreasuryKeeper.PruneIndicators(input.Ctx)
+
+	// the indicators of the long window are kept
+	cutoffEpoch := lastEpoch - params.WindowLong + 1
+	require.Equal(t, cutoffEpoch, input.TreasuryKeeper.GetIndicatorCutoffEpoch(input.Ctx))
+	require.True(t, input.TreasuryKeeper.GetTR(input.Ctx, cutoffEpoch-1).IsZero())
+	for epoch := cutoffEpoch; epoch <= lastEpoch; epoch++ {
+		require.Equal(t, sdk.NewDec(epoch+1), input.TreasuryKeeper.GetTR(input.Ctx, epoch))
+	}
+}
@@ -24,8 +24,10 @@ type Keeper struct {
 
 	supplyKeeper  types.SupplyKeeper
 	marketKeeper  types.MarketKeeper
+	oracleKeeper  types.OracleKeeper
 	stakingKeeper types.StakingKeeper
 	distrKeeper   types.DistributionKeeper
+	hooks         types.TreasuryHooks
 
 	oracleModuleName       string
 	distributionModuleName string
@@ -33,7 +35,7 @@ type Keeper struct {
 
 // NewKeeper creates a new treasury Keeper instance
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
-	supplyKeeper types.SupplyKeeper, marketKeeper types.MarketKeeper,
+	supplyKeeper types.SupplyKeeper, marketKeeper types.MarketKeeper, oracleKeeper types.OracleKeeper,
 	stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	oracleModuleName string, distributionModuleName string, codespace sdk.CodespaceType) Keeper {
 
@@ -44,6 +46,7 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspa
 		codespace:              codespace,
 		supplyKeeper:           supplyKeeper,
 		marketKeeper:           marketKeeper,
+		oracleKeeper:           oracleKeeper,
 		stakingKeeper:          stakingKeeper,
 		distrKeeper:            distrKeeper,
 		oracleModuleName:       oracleModuleName,
@@ -51,6 +54,16 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspa
 	}
 }
 
+// SetHooks sets the treasury hooks; the keeper is passed by value, so the hooks must be
+// set before the keeper is handed to other modules
+func (k *Keeper) SetHooks(th types.TreasuryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set treasury hooks twice")
+	}
+	k.hooks = th
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -106,8 +119,13 @@ func (k Keeper) SetTaxCap(ctx sdk.Context, denom string, cap sdk.Int) {
 	store.Set(types.GetTaxCapKey(denom), bz)
 }
 
-// GetTaxCap gets the tax cap denominated in integer units of the reference {denom}
+// GetTaxCap gets the tax cap denominated in integer units of the reference {denom};
+// a tax cap pinned by governance takes precedence over the converted one
 func (k Keeper) GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int) {
+	if pin, found := k.GetTaxCapPin(ctx, denom); found && pin.IsActive(k.GetEpoch(ctx)) {
+		return pin.TaxCap
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(types.GetTaxCapKey(denom))
@@ -139,6 +157,63 @@ func (k Keeper) IterateTaxCap(ctx sdk.Context, handler func(denom string, taxCap
 	return
 }
 
+// SetTaxRateOverride sets the tax-rate which replaces the global tax-rate for the {denom}
+func (k Keeper) SetTaxRateOverride(ctx sdk.Context, denom string, taxRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(taxRate)
+	store.Set(types.GetTaxRateOverrideKey(denom), bz)
+}
+
+// GetTaxRateOverride gets the tax-rate override of the {denom}
+func (k Keeper) GetTaxRateOverride(ctx sdk.Context, denom string) (taxRate sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxRateOverrideKey(denom))
+	if bz == nil {
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &taxRate)
+	return taxRate, true
+}
+
+// IterateTaxRateOverrides iterates all tax-rate overrides
+func (k Keeper) IterateTaxRateOverrides(ctx sdk.Context, handler func(denom string, taxRate sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxRateOverrideKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.TaxRateOverrideKey):])
+		var taxRate sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &taxRate)
+
+		if handler(denom, taxRate) {
+			break
+		}
+	}
+}
+
+// GetTaxRateOverrides returns all tax-rate overrides
+func (k Keeper) GetTaxRateOverrides(ctx sdk.Context) (overrides types.TaxRateOverrides) {
+	overrides = types.TaxRateOverrides{}
+	k.IterateTaxRateOverrides(ctx, func(denom string, taxRate sdk.Dec) bool {
+		overrides = append(overrides, types.NewTaxRateOverride(denom, taxRate))
+		return false
+	})
+
+	return
+}
+
+// GetDenomTaxRate returns the tax-rate applied to the {denom};
+// the override of the denom if registered, the global tax-rate otherwise
+func (k Keeper) GetDenomTaxRate(ctx sdk.Context, denom string) sdk.Dec {
+	if taxRate, found := k.GetTaxRateOverride(ctx, denom); found {
+		return taxRate
+	}
+
+	return k.GetTaxRate(ctx)
+}
+
 // RecordEpochTaxProceeds adds tax proceeds that have been added this epoch
 func (k Keeper) RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins) {
 	if delta.IsZero() {
@@ -179,6 +254,9 @@ func (k Keeper) PeekEpochTaxProceeds(ctx sdk.Context) (res sdk.Coins) {
 func (k Keeper) RecordEpochInitialIssuance(ctx sdk.Context) {
 	totalCoins := k.supplyKeeper.GetSupply(ctx).GetTotal()
 	k.SetEpochInitialIssuance(ctx, totalCoins)
+
+	// the new initial issuance already reflects the burns of the past epoch
+	k.SetEpochBurns(ctx, sdk.Coins{})
 }
 
 // SetEpochInitialIssuance stores epoch initial issuance
@@ -209,6 +287,10 @@ func (k Keeper) GetEpochInitialIssuance(ctx sdk.Context) (res sdk.Coins) {
 // PeekEpochSeigniorage returns epoch seigniorage
 func (k Keeper) PeekEpochSeigniorage(ctx sdk.Context) sdk.Int {
 	epochIssuance := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(core.MicroLunaDenom)
+
+	// voluntary burns reduce the supply without being swap seigniorage
+	epochIssuance = epochIssuance.Add(k.GetEpochBurns(ctx).AmountOf(core.MicroLunaDenom))
+
 	preEpochIssuance := k.GetEpochInitialIssuance(ctx).AmountOf(core.MicroLunaDenom)
 	epochSeigniorage := preEpochIssuance.Sub(epochIssuance)
 
@@ -252,6 +334,22 @@ func (k Keeper) ClearTRs(ctx sdk.Context) {
 	}
 }
 
+// IterateTRs iterates the tax rewards of all recorded epochs
+func (k Keeper) IterateTRs(ctx sdk.Context, handler func(epoch int64, TR sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TRKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var TR sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &TR)
+
+		if handler(types.GetEpochFromSubkey(iter.Key()), TR) {
+			break
+		}
+	}
+}
+
 // GetSR returns the seigniorage rewards for the epoch
 func (k Keeper) GetSR(ctx sdk.Context, epoch int64) (res sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
@@ -285,6 +383,22 @@ func (k Keeper) ClearSRs(ctx sdk.Context) {
 	}
 }
 
+// IterateSRs iterates the seigniorage rewards of all recorded epochs
+func (k Keeper) IterateSRs(ctx sdk.Context, handler func(epoch int64, SR sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SRKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var SR sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &SR)
+
+		if handler(types.GetEpochFromSubkey(iter.Key()), SR) {
+			break
+		}
+	}
+}
+
 // GetTSL returns the total saked luna for the epoch
 func (k Keeper) GetTSL(ctx sdk.Context, epoch int64) (res sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
@@ -317,3 +431,57 @@ func (k Keeper) ClearTSLs(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// IterateTSLs iterates the total staked luna of all recorded epochs
+func (k Keeper) IterateTSLs(ctx sdk.Context, handler func(epoch int64, TSL sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TSLKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var TSL sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &TSL)
+
+		if handler(types.GetEpochFromSubkey(iter.Key()), TSL) {
+			break
+		}
+	}
+}
+
+// GetPolicyIndicators returns the rolling values the policy update of the epoch computed;
+// found is false when the policy was not updated at the epoch
+func (k Keeper) GetPolicyIndicators(ctx sdk.Context, epoch int64) (res types.PolicyIndicators, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPolicyIndicatorsKey(epoch))
+
+	if bz == nil {
+		return types.NewPolicyIndicators(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	return res, true
+}
+
+// SetPolicyIndicators stores the rolling values the policy update of the epoch computed
+func (k Keeper) SetPolicyIndicators(ctx sdk.Context, epoch int64, indicators types.PolicyIndicators) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(indicators)
+	store.Set(types.GetPolicyIndicatorsKey(epoch), bz)
+}
+
+// IteratePolicyIndicators iterates the rolling values of all recorded policy updates
+func (k Keeper) IteratePolicyIndicators(ctx sdk.Context, handler func(epoch int64, indicators types.PolicyIndicators) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PolicyIndicatorsKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var indicators types.PolicyIndicators
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &indicators)
+
+		if handler(types.GetEpochFromSubkey(iter.Key()), indicators) {
+			break
+		}
+	}
+}
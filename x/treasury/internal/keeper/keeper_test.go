@@ -32,6 +32,29 @@ func TestTaxRate(t *testing.T) {
 	}
 }
 
+func TestTaxRateOverride(t *testing.T) {
+	input := CreateTestInput(t)
+
+	taxRate := sdk.NewDecWithPrec(1, 3)
+	input.TreasuryKeeper.SetTaxRate(input.Ctx, taxRate)
+
+	_, found := input.TreasuryKeeper.GetTaxRateOverride(input.Ctx, core.MicroKRWDenom)
+	require.False(t, found)
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+
+	krwRate := sdk.NewDecWithPrec(5, 3)
+	input.TreasuryKeeper.SetTaxRateOverride(input.Ctx, core.MicroKRWDenom, krwRate)
+	require.Equal(t, krwRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroSDRDenom))
+
+	// the global tax-rate does not change the override
+	input.TreasuryKeeper.SetTaxRate(input.Ctx, sdk.NewDecWithPrec(2, 3))
+	require.Equal(t, krwRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+
+	require.Equal(t, types.TaxRateOverrides{types.NewTaxRateOverride(core.MicroKRWDenom, krwRate)},
+		input.TreasuryKeeper.GetTaxRateOverrides(input.Ctx))
+}
+
 func TestTaxCap(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -53,6 +53,30 @@ func (k Keeper) WindowProbation(ctx sdk.Context) (res int64) {
 	return
 }
 
+// EpochLength is the number of blocks per epoch; a change takes effect from the next epoch
+func (k Keeper) EpochLength(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyEpochLength, &res)
+	return
+}
+
+// SeigniorageRoutes are the recipients of the seigniorage left after the oracle reward
+func (k Keeper) SeigniorageRoutes(ctx sdk.Context) (res types.SeigniorageRoutes) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeySeigniorageRoutes, &res)
+	return
+}
+
+// IndicatorRetention is the number of epochs the indicators are kept for before pruned
+func (k Keeper) IndicatorRetention(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyIndicatorRetention, &res)
+	return
+}
+
+// TaxPaymentIndex returns whether the stability tax paid by each address is recorded
+func (k Keeper) TaxPaymentIndex(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTaxPaymentIndex, &res)
+	return
+}
+
 // GetParams returns the total set of treasury parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// RecordTaxPayment adds the taxes paid by the payer to the tax payment of the current epoch.
+// Nothing is recorded unless the TaxPaymentIndex param is enabled.
+func (k Keeper) RecordTaxPayment(ctx sdk.Context, payer sdk.AccAddress, taxes sdk.Coins) {
+	if taxes.Empty() || !k.TaxPaymentIndex(ctx) {
+		return
+	}
+
+	epoch := k.GetEpoch(ctx)
+	store := ctx.KVStore(k.storeKey)
+	for _, tax := range taxes {
+		key := types.GetTaxPaymentKey(epoch, payer, tax.Denom)
+
+		amount := tax.Amount
+		if bz := store.Get(key); bz != nil {
+			var paid sdk.Int
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &paid)
+			amount = amount.Add(paid)
+		}
+
+		store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+	}
+}
+
+// SetTaxPayment stores the tax payment, replacing the amounts of its denoms
+func (k Keeper) SetTaxPayment(ctx sdk.Context, payment types.TaxPayment) {
+	store := ctx.KVStore(k.storeKey)
+	for _, tax := range payment.Amount {
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(tax.Amount)
+		store.Set(types.GetTaxPaymentKey(payment.Epoch, payment.Payer, tax.Denom), bz)
+	}
+}
+
+// GetTaxPayment returns the taxes paid by the payer during the epoch
+func (k Keeper) GetTaxPayment(ctx sdk.Context, epoch int64, payer sdk.AccAddress) (taxes sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTaxPaymentPayerKey(epoch, payer))
+
+	taxes = sdk.Coins{}
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, denom := types.ParseTaxPaymentKey(iter.Key())
+
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &amount)
+		taxes = append(taxes, sdk.NewCoin(denom, amount))
+	}
+
+	return
+}
+
+// GetTaxPaymentsByPayer returns the tax payments of the payer over [startEpoch, endEpoch];
+// epochs without any tax paid are skipped
+func (k Keeper) GetTaxPaymentsByPayer(ctx sdk.Context, payer sdk.AccAddress, startEpoch, endEpoch int64) (payments types.TaxPayments) {
+	payments = types.TaxPayments{}
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if taxes := k.GetTaxPayment(ctx, epoch, payer); !taxes.Empty() {
+			payments = append(payments, types.NewTaxPayment(epoch, payer, taxes))
+		}
+	}
+
+	return
+}
+
+// IterateTaxPayments iterates the tax payments of all payers in epoch order
+func (k Keeper) IterateTaxPayments(ctx sdk.Context, handler func(payment types.TaxPayment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxPaymentKey)
+
+	defer iter.Close()
+
+	// denoms of a payment are stored under consecutive keys, so a payment
+	// is complete once the iteration moves on to the next epoch or payer
+	var payment *types.TaxPayment
+	for ; iter.Valid(); iter.Next() {
+		epoch, payer, denom := types.ParseTaxPaymentKey(iter.Key())
+
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &amount)
+
+		if payment != nil && (payment.Epoch != epoch || !payment.Payer.Equals(payer)) {
+			if handler(*payment) {
+				return
+			}
+			payment = nil
+		}
+
+		if payment == nil {
+			payment = &types.TaxPayment{Epoch: epoch, Payer: payer, Amount: sdk.Coins{}}
+		}
+
+		payment.Amount = append(payment.Amount, sdk.NewCoin(denom, amount))
+	}
+
+	if payment != nil {
+		handler(*payment)
+	}
+}
+
+// GetTaxPayments returns the tax payments of all payers
+func (k Keeper) GetTaxPayments(ctx sdk.Context) (payments types.TaxPayments) {
+	payments = types.TaxPayments{}
+	k.IterateTaxPayments(ctx, func(payment types.TaxPayment) bool {
+		payments = append(payments, payment)
+		return false
+	})
+
+	return
+}
+
+// PruneTaxPayments deletes the tax payments recorded before the cutoff epoch
+func (k Keeper) PruneTaxPayments(ctx sdk.Context, cutoffEpoch int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.TaxPaymentKey, types.GetTaxPaymentEpochKey(cutoffEpoch))
+
+	var prunedKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		prunedKeys = append(prunedKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+}
+
+// ClearTaxPayments deletes the tax payments of all epochs
+func (k Keeper) ClearTaxPayments(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxPaymentKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
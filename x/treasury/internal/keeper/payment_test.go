@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+func TestRecordTaxPayment(t *testing.T) {
+	input := CreateTestInput(t)
+
+	taxes := sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroKRWDenom, 1000),
+		sdk.NewInt64Coin(core.MicroSDRDenom, 10),
+	)
+
+	// disabled by default
+	input.TreasuryKeeper.RecordTaxPayment(input.Ctx, Addrs[0], taxes)
+	require.True(t, input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[0]).Empty())
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.TaxPaymentIndex = true
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// payments accumulate within an epoch
+	input.TreasuryKeeper.RecordTaxPayment(input.Ctx, Addrs[0], taxes)
+	input.TreasuryKeeper.RecordTaxPayment(input.Ctx, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 500)))
+	input.TreasuryKeeper.RecordTaxPayment(input.Ctx, Addrs[1], taxes)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroKRWDenom, 1500),
+		sdk.NewInt64Coin(core.MicroSDRDenom, 10),
+	), input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[0]))
+	require.Equal(t, taxes, input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[1]))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * 2)
+	input.TreasuryKeeper.RecordTaxPayment(input.Ctx, Addrs[0], taxes)
+
+	payments := input.TreasuryKeeper.GetTaxPaymentsByPayer(input.Ctx, Addrs[0], 0, 2)
+	require.Equal(t, types.TaxPayments{
+		types.NewTaxPayment(0, Addrs[0], input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[0])),
+		types.NewTaxPayment(2, Addrs[0], taxes),
+	}, payments)
+
+	// payments are grouped by epoch and payer
+	payments = input.TreasuryKeeper.GetTaxPayments(input.Ctx)
+	require.Equal(t, 3, len(payments))
+	require.Equal(t, int64(2), payments[2].Epoch)
+	require.NoError(t, payments.Validate())
+
+	input.TreasuryKeeper.PruneTaxPayments(input.Ctx, 1)
+	require.True(t, input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[0]).Empty())
+	require.True(t, input.TreasuryKeeper.GetTaxPayment(input.Ctx, 0, Addrs[1]).Empty())
+	require.Equal(t, taxes, input.TreasuryKeeper.GetTaxPayment(input.Ctx, 2, Addrs[0]))
+
+	input.TreasuryKeeper.ClearTaxPayments(input.Ctx)
+	require.Equal(t, 0, len(input.TreasuryKeeper.GetTaxPayments(input.Ctx)))
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// SetTaxCapPin stores the tax-cap pin; an existing pin for the same denom is replaced
+func (k Keeper) SetTaxCapPin(ctx sdk.Context, pin types.TaxCapPin) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pin)
+	store.Set(types.GetTaxCapPinKey(pin.Denom), bz)
+}
+
+// GetTaxCapPin returns the tax-cap pin of the denom
+func (k Keeper) GetTaxCapPin(ctx sdk.Context, denom string) (pin types.TaxCapPin, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxCapPinKey(denom))
+	if bz == nil {
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pin)
+	return pin, true
+}
+
+// DeleteTaxCapPin removes the tax-cap pin of the denom
+func (k Keeper) DeleteTaxCapPin(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTaxCapPinKey(denom))
+}
+
+// IterateTaxCapPins iterates all tax-cap pins
+func (k Keeper) IterateTaxCapPins(ctx sdk.Context, handler func(pin types.TaxCapPin) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxCapPinKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pin types.TaxCapPin
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &pin)
+
+		if handler(pin) {
+			break
+		}
+	}
+}
+
+// GetTaxCapPins returns all tax-cap pins
+func (k Keeper) GetTaxCapPins(ctx sdk.Context) (pins types.TaxCapPins) {
+	pins = types.TaxCapPins{}
+	k.IterateTaxCapPins(ctx, func(pin types.TaxCapPin) bool {
+		pins = append(pins, pin)
+		return false
+	})
+
+	return
+}
+
+// ClearExpiredTaxCapPins removes the tax-cap pins not in effect from the next epoch.
+// Must be called at the last block of an epoch.
+func (k Keeper) ClearExpiredTaxCapPins(ctx sdk.Context) {
+	nextEpoch := k.GetEpoch(ctx) + 1
+
+	var expired []string
+	k.IterateTaxCapPins(ctx, func(pin types.TaxCapPin) bool {
+		if !pin.IsActive(nextEpoch) {
+			expired = append(expired, pin.Denom)
+		}
+		return false
+	})
+
+	for _, denom := range expired {
+		k.DeleteTaxCapPin(ctx, denom)
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+func TestTaxCapPin(t *testing.T) {
+	input := CreateTestInput(t)
+
+	taxCap := sdk.NewInt(1000)
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, core.MicroKRWDenom, taxCap)
+
+	// pinned tax-cap takes precedence until the end of the expiry epoch
+	pinnedCap := sdk.NewInt(2000)
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, types.NewTaxCapPin(core.MicroKRWDenom, pinnedCap, 1))
+	require.Equal(t, pinnedCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch - 1)
+	input.TreasuryKeeper.ClearExpiredTaxCapPins(input.Ctx)
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetTaxCapPins(input.Ctx)))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch*2 - 1)
+	require.Equal(t, pinnedCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+	input.TreasuryKeeper.ClearExpiredTaxCapPins(input.Ctx)
+	require.Equal(t, 0, len(input.TreasuryKeeper.GetTaxCapPins(input.Ctx)))
+	require.Equal(t, taxCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+
+	// expired pins are ignored before they are cleared
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch*3 - 1)
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, types.NewTaxCapPin(core.MicroKRWDenom, pinnedCap, 0))
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, types.NewTaxCapPin(core.MicroSDRDenom, pinnedCap, 1))
+	require.Equal(t, pinnedCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, input.TreasuryKeeper.TaxPolicy(input.Ctx).Cap.Amount, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroSDRDenom))
+
+	input.TreasuryKeeper.ClearExpiredTaxCapPins(input.Ctx)
+	require.Equal(t, types.TaxCapPins{types.NewTaxCapPin(core.MicroKRWDenom, pinnedCap, 0)}, input.TreasuryKeeper.GetTaxCapPins(input.Ctx))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,8 +22,8 @@ func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 		newDecCap, err := k.marketKeeper.ComputeInternalSwap(ctx, cap, coin.Denom)
 		if err == nil {
 			newCap, _ := newDecCap.TruncateDecimal()
-			newCaps = append(newCaps, newCap)
 			k.SetTaxCap(ctx, newCap.Denom, newCap.Amount)
+			newCaps = append(newCaps, sdk.NewCoin(newCap.Denom, k.GetTaxCap(ctx, newCap.Denom)))
 		}
 	}
 
@@ -30,6 +31,8 @@ func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 }
 
 // UpdateTaxPolicy updates tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
+// The updated tax-rate applies to every denom without tax-rate override;
+// overridden denoms keep the rate set by governance.
 func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 	params := k.GetParams(ctx)
 
@@ -38,6 +41,11 @@ func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 	tlYear := k.rollingAverageIndicator(ctx, params.WindowLong, TRL)
 	tlMonth := k.rollingAverageIndicator(ctx, params.WindowShort, TRL)
 
+	k.recordPolicyIndicators(ctx, func(indicators *types.PolicyIndicators) {
+		indicators.TRLYear = tlYear
+		indicators.TRLMonth = tlMonth
+	})
+
 	// No revenues, hike as much as possible.
 	if tlMonth.Equal(sdk.ZeroDec()) {
 		newTaxRate = params.TaxPolicy.RateMax
@@ -62,6 +70,11 @@ func (k Keeper) UpdateRewardPolicy(ctx sdk.Context) (newRewardWeight sdk.Dec) {
 	seigniorageSum := k.sumIndicator(ctx, params.WindowShort, SR)
 	totalSum := k.sumIndicator(ctx, params.WindowShort, MR)
 
+	k.recordPolicyIndicators(ctx, func(indicators *types.PolicyIndicators) {
+		indicators.SRSum = seigniorageSum
+		indicators.MRSum = totalSum
+	})
+
 	// No revenues; hike as much as possible
 	if totalSum.Equal(sdk.ZeroDec()) || seigniorageSum.Equal(sdk.ZeroDec()) {
 		newRewardWeight = params.RewardPolicy.RateMax
@@ -77,3 +90,91 @@ func (k Keeper) UpdateRewardPolicy(ctx sdk.Context) (newRewardWeight sdk.Dec) {
 	k.SetRewardWeight(ctx, newRewardWeight)
 	return
 }
+
+// recordPolicyIndicators updates the rolling values recorded for the policy update of the current epoch
+func (k Keeper) recordPolicyIndicators(ctx sdk.Context, update func(indicators *types.PolicyIndicators)) {
+	epoch := k.GetEpoch(ctx)
+	indicators, _ := k.GetPolicyIndicators(ctx, epoch)
+	update(&indicators)
+	k.SetPolicyIndicators(ctx, epoch, indicators)
+}
+
+// EndEpoch runs the epoch end steps of the EndBlocker: it records the indicators of the current
+// epoch, drops those out of the retention, clears the expiring tax-cap pins and, past the probation
+// period, settles the seigniorage and updates the policy of the next epoch. updated is false when
+// the policy is left unchanged. Must be called at the last block of an epoch.
+func (k Keeper) EndEpoch(ctx sdk.Context) (taxRate, rewardWeight sdk.Dec, taxCaps sdk.Coins, updated bool) {
+	return k.endEpoch(ctx, nil)
+}
+
+// endEpoch runs the epoch end steps; overrideIndicators may replace the recorded indicators
+// of the current epoch before the policy is updated from them
+func (k Keeper) endEpoch(ctx sdk.Context, overrideIndicators func(ctx sdk.Context, epoch int64)) (
+	taxRate, rewardWeight sdk.Dec, taxCaps sdk.Coins, updated bool) {
+
+	// Compute & Update internal indicators for the current epoch
+	k.UpdateIndicators(ctx)
+	if overrideIndicators != nil {
+		overrideIndicators(ctx, k.GetEpoch(ctx))
+	}
+
+	// Drop the indicators fallen out of the retention
+	k.PruneIndicators(ctx)
+
+	// Pinned tax caps expiring at this epoch give way to the converted caps
+	k.ClearExpiredTaxCapPins(ctx)
+
+	// Check probation period
+	if k.GetEpoch(ctx) < k.WindowProbation(ctx) {
+		return k.GetTaxRate(ctx), k.GetRewardWeight(ctx), nil, false
+	}
+
+	// Settle seiniorage to oracle & distribution(community-pool) module-account
+	k.SettleSeigniorage(ctx)
+
+	// Update tax-rate and reward-weight of next epoch
+	taxRate = k.UpdateTaxPolicy(ctx)
+	rewardWeight = k.UpdateRewardPolicy(ctx)
+	taxCaps = k.UpdateTaxCap(ctx)
+
+	return taxRate, rewardWeight, taxCaps, true
+}
+
+// ProjectPolicy runs the epoch end steps on a cached context and returns the next tax-rate,
+// reward-weight and tax-caps; no state is written to the given context. The policy stays
+// unchanged during the probation period, as it does at the epoch end.
+// Hypothetical params and current epoch indicators can be supplied to override the stored ones.
+func (k Keeper) ProjectPolicy(ctx sdk.Context, overrides types.QueryProjectPolicyParams) types.PolicyProjection {
+	cacheCtx, _ := ctx.CacheContext()
+
+	if overrides.Params != nil {
+		k.SetParams(cacheCtx, *overrides.Params)
+	}
+
+	taxRate, rewardWeight, _, _ := k.endEpoch(cacheCtx, func(ctx sdk.Context, epoch int64) {
+		if overrides.TR != nil {
+			k.SetTR(ctx, epoch, *overrides.TR)
+		}
+
+		if overrides.SR != nil {
+			k.SetSR(ctx, epoch, *overrides.SR)
+		}
+
+		if overrides.TSL != nil {
+			k.SetTSL(ctx, epoch, *overrides.TSL)
+		}
+	})
+
+	taxCaps := sdk.Coins{}
+	k.IterateTaxCap(cacheCtx, func(denom string, _ sdk.Int) bool {
+		taxCaps = append(taxCaps, sdk.NewCoin(denom, k.GetTaxCap(cacheCtx, denom)))
+		return false
+	})
+
+	return types.PolicyProjection{
+		TaxRate:      taxRate,
+		RewardWeight: rewardWeight,
+		TaxCaps:      taxCaps.Sort(),
+		Indicators:   k.GetEpochIndicators(cacheCtx, k.GetEpoch(cacheCtx)),
+	}
+}
@@ -97,3 +97,107 @@ func TestUpdateTaxCap(t *testing.T) {
 	sdrCapAmt := input.TreasuryKeeper.GetParams(input.Ctx).TaxPolicy.Cap.Amount
 	require.Equal(t, krwCap, krwPrice.Quo(sdrPrice).MulInt(sdrCapAmt).TruncateInt())
 }
+
+func TestProjectPolicy(t *testing.T) {
+	input, _ := setupValidators(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.WindowProbation = 0
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	input.SupplyKeeper.SetSupply(input.Ctx,
+		input.SupplyKeeper.GetSupply(input.Ctx).SetTotal(
+			sdk.NewCoins(
+				sdk.NewInt64Coin(core.MicroLunaDenom, 1000000),
+				sdk.NewInt64Coin(core.MicroSDRDenom, 1000000),
+				sdk.NewInt64Coin(core.MicroKRWDenom, 1000000),
+			),
+		),
+	)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)))
+
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+	rewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
+	taxProceeds := input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx)
+
+	projection := input.TreasuryKeeper.ProjectPolicy(input.Ctx, types.QueryProjectPolicyParams{})
+
+	// Nothing is written to the store
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.Equal(t, rewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
+	require.Equal(t, taxProceeds, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+	require.Equal(t, sdk.ZeroDec(), input.TreasuryKeeper.GetTR(input.Ctx, 0))
+
+	// Projection matches the real epoch end
+	nextTaxRate, nextRewardWeight, nextTaxCaps, updated := input.TreasuryKeeper.EndEpoch(input.Ctx)
+	require.True(t, updated)
+	require.Equal(t, input.TreasuryKeeper.GetEpochIndicators(input.Ctx, 0), projection.Indicators)
+	require.Equal(t, nextTaxRate, projection.TaxRate)
+	require.Equal(t, nextRewardWeight, projection.RewardWeight)
+	require.Equal(t, nextTaxCaps, projection.TaxCaps)
+}
+
+func TestProjectPolicyProbation(t *testing.T) {
+	input, _ := setupValidators(t)
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)))
+
+	// The policy is left unchanged during the probation period
+	require.True(t, input.TreasuryKeeper.GetEpoch(input.Ctx) < input.TreasuryKeeper.WindowProbation(input.Ctx))
+	projection := input.TreasuryKeeper.ProjectPolicy(input.Ctx, types.QueryProjectPolicyParams{})
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), projection.TaxRate)
+	require.Equal(t, input.TreasuryKeeper.GetRewardWeight(input.Ctx), projection.RewardWeight)
+
+	_, _, _, updated := input.TreasuryKeeper.EndEpoch(input.Ctx)
+	require.False(t, updated)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), projection.TaxRate)
+}
+
+func TestProjectPolicyExpiringTaxCapPin(t *testing.T) {
+	input, _ := setupValidators(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.WindowProbation = 0
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	input.SupplyKeeper.SetSupply(input.Ctx,
+		input.SupplyKeeper.GetSupply(input.Ctx).SetTotal(
+			sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1000000)),
+		),
+	)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+
+	// The pin expires with the current epoch, so the converted cap applies from the next one
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	pinnedCap := sdk.NewInt(1)
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, types.NewTaxCapPin(core.MicroKRWDenom, pinnedCap, 1))
+	require.Equal(t, pinnedCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+
+	projection := input.TreasuryKeeper.ProjectPolicy(input.Ctx, types.QueryProjectPolicyParams{})
+	convertedCap := projection.TaxCaps.AmountOf(core.MicroKRWDenom)
+	require.False(t, convertedCap.Equal(pinnedCap))
+
+	input.TreasuryKeeper.EndEpoch(input.Ctx)
+	require.Equal(t, convertedCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+}
+
+func TestProjectPolicyWithOverrides(t *testing.T) {
+	input, _ := setupValidators(t)
+
+	// Huge hypothetical tax rewards and a tight tax policy, past the probation period
+	TR := sdk.NewDec(1000000000000)
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.WindowProbation = 0
+	params.TaxPolicy.ChangeRateMax = sdk.NewDecWithPrec(1, 5)
+
+	projection := input.TreasuryKeeper.ProjectPolicy(input.Ctx, types.NewQueryProjectPolicyParams(&params, &TR, nil, nil))
+	require.Equal(t, TR, projection.Indicators.TR)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx).Add(params.TaxPolicy.ChangeRateMax), projection.TaxRate)
+
+	// Overrides are not persisted
+	require.Equal(t, sdk.ZeroDec(), input.TreasuryKeeper.GetTR(input.Ctx, 0))
+	require.Equal(t, types.DefaultTaxPolicy, input.TreasuryKeeper.TaxPolicy(input.Ctx))
+}
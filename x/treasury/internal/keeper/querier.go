@@ -5,7 +5,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
@@ -14,7 +13,7 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case types.QueryTaxRate:
-			return queryTaxRate(ctx, keeper)
+			return queryTaxRate(ctx, req, keeper)
 		case types.QueryTaxCap:
 			return queryTaxCap(ctx, req, keeper)
 		case types.QueryRewardWeight:
@@ -25,6 +24,24 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTaxProceeds(ctx, keeper)
 		case types.QueryParameters:
 			return queryParameters(ctx, keeper)
+		case types.QueryCurrentEpoch:
+			return queryCurrentEpoch(ctx, keeper)
+		case types.QueryIndicators:
+			return queryIndicators(ctx, req, keeper)
+		case types.QueryProjectPolicy:
+			return queryProjectPolicy(ctx, req, keeper)
+		case types.QueryTaxExemptions:
+			return queryTaxExemptions(ctx, keeper)
+		case types.QueryTaxRateOverrides:
+			return queryTaxRateOverrides(ctx, keeper)
+		case types.QuerySeigniorageSettlement:
+			return querySeigniorageSettlement(ctx, keeper)
+		case types.QueryTaxCapPins:
+			return queryTaxCapPins(ctx, keeper)
+		case types.QueryTaxPayments:
+			return queryTaxPayments(ctx, req, keeper)
+		case types.QueryBurns:
+			return queryBurns(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown treasury query endpoint")
 		}
@@ -32,7 +49,7 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 }
 
 func queryCurrentEpoch(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
-	curEpoch := core.GetEpoch(ctx)
+	curEpoch := keeper.GetEpoch(ctx)
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, curEpoch)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -40,8 +57,75 @@ func queryCurrentEpoch(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return bz, nil
 }
 
-func queryTaxRate(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+func queryIndicators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryIndicatorsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	curEpoch := keeper.GetEpoch(ctx)
+	if params.StartEpoch < 0 || params.StartEpoch > curEpoch {
+		return nil, types.ErrInvalidEpoch(keeper.codespace, curEpoch, params.StartEpoch)
+	}
+
+	if cutoffEpoch := keeper.GetIndicatorCutoffEpoch(ctx); params.StartEpoch < cutoffEpoch {
+		return nil, types.ErrPrunedEpoch(keeper.codespace, cutoffEpoch, params.StartEpoch)
+	}
+
+	if params.EndEpoch < params.StartEpoch || params.EndEpoch > curEpoch {
+		return nil, types.ErrInvalidEpoch(keeper.codespace, curEpoch, params.EndEpoch)
+	}
+
+	indicators := types.EpochIndicatorsList{}
+	for epoch := params.StartEpoch; epoch <= params.EndEpoch; epoch++ {
+		indicators = append(indicators, keeper.GetEpochIndicators(ctx, epoch))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, indicators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryProjectPolicy(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProjectPolicyParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Params != nil {
+		if err := params.Params.Validate(); err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid params", err.Error()))
+		}
+	}
+
+	projection := keeper.ProjectPolicy(ctx, params)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, projection)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTaxRate(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTaxRateParams
+	if len(req.Data) != 0 {
+		err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+		}
+	}
+
 	taxRate := keeper.GetTaxRate(ctx)
+	if len(params.Denom) != 0 {
+		taxRate = keeper.GetDenomTaxRate(ctx, params.Denom)
+	}
+
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, taxRate)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -94,6 +178,15 @@ func queryTaxProceeds(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return bz, nil
 }
 
+func queryBurns(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	burns := keeper.GetTotalBurns(ctx)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, burns)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
 	if err != nil {
@@ -101,3 +194,68 @@ func queryParameters(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	}
 	return bz, nil
 }
+
+func queryTaxExemptions(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTaxExemptions(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTaxRateOverrides(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTaxRateOverrides(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySeigniorageSettlement(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetSeigniorageSettlement(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTaxCapPins(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTaxCapPins(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTaxPayments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTaxPaymentsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Payer.Empty() {
+		return nil, sdk.ErrInvalidAddress("payer cannot be empty")
+	}
+
+	curEpoch := keeper.GetEpoch(ctx)
+	if params.StartEpoch < 0 || params.StartEpoch > curEpoch {
+		return nil, types.ErrInvalidEpoch(keeper.codespace, curEpoch, params.StartEpoch)
+	}
+
+	if cutoffEpoch := keeper.GetIndicatorCutoffEpoch(ctx); params.StartEpoch < cutoffEpoch {
+		return nil, types.ErrPrunedEpoch(keeper.codespace, cutoffEpoch, params.StartEpoch)
+	}
+
+	if params.EndEpoch < params.StartEpoch || params.EndEpoch > curEpoch {
+		return nil, types.ErrInvalidEpoch(keeper.codespace, curEpoch, params.EndEpoch)
+	}
+
+	payments := keeper.GetTaxPaymentsByPayer(ctx, params.Payer, params.StartEpoch, params.EndEpoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, payments)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -161,6 +161,49 @@ func TestQueryTaxRate(t *testing.T) {
 	require.Equal(t, queriedTaxRate, taxRate)
 }
 
+func TestQueryDenomTaxRate(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	taxRate := sdk.NewDecWithPrec(1, 3)
+	krwRate := sdk.NewDecWithPrec(5, 3)
+	input.TreasuryKeeper.SetTaxRate(input.Ctx, taxRate)
+	input.TreasuryKeeper.SetTaxRateOverride(input.Ctx, core.MicroKRWDenom, krwRate)
+
+	queryTaxRate := func(denom string) sdk.Dec {
+		bz, err := input.Cdc.MarshalJSON(types.NewQueryTaxRateParams(denom))
+		require.NoError(t, err)
+
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTaxRate}, "/"),
+			Data: bz,
+		}
+
+		res, err := querier(input.Ctx, []string{types.QueryTaxRate}, query)
+		require.NoError(t, err)
+
+		var response sdk.Dec
+		require.NoError(t, input.Cdc.UnmarshalJSON(res, &response))
+		return response
+	}
+
+	require.Equal(t, krwRate, queryTaxRate(core.MicroKRWDenom))
+	require.Equal(t, taxRate, queryTaxRate(core.MicroSDRDenom))
+	require.Equal(t, taxRate, queryTaxRate(""))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTaxRateOverrides}, "/"),
+		Data: nil,
+	}
+
+	res, err := querier(input.Ctx, []string{types.QueryTaxRateOverrides}, query)
+	require.NoError(t, err)
+
+	var overrides types.TaxRateOverrides
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &overrides))
+	require.Equal(t, types.TaxRateOverrides{types.NewTaxRateOverride(core.MicroKRWDenom, krwRate)}, overrides)
+}
+
 func TestQueryTaxCap(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.TreasuryKeeper)
@@ -171,6 +214,24 @@ func TestQueryTaxCap(t *testing.T) {
 	queriedTaxCap := getQueriedTaxCap(t, input.Ctx, input.Cdc, querier, "hello")
 
 	require.Equal(t, queriedTaxCap, params.TaxPolicy.Cap.Amount)
+
+	// pinned tax-cap is queried instead
+	pin := types.NewTaxCapPin("hello", sdk.NewInt(123), 0)
+	input.TreasuryKeeper.SetTaxCapPin(input.Ctx, pin)
+	queriedTaxCap = getQueriedTaxCap(t, input.Ctx, input.Cdc, querier, "hello")
+	require.Equal(t, pin.TaxCap, queriedTaxCap)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTaxCapPins}, "/"),
+		Data: nil,
+	}
+
+	res, err := querier(input.Ctx, []string{types.QueryTaxCapPins}, query)
+	require.NoError(t, err)
+
+	var pins types.TaxCapPins
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &pins))
+	require.Equal(t, types.TaxCapPins{pin}, pins)
 }
 
 func TestQueryTaxProceeds(t *testing.T) {
@@ -206,3 +267,199 @@ func TestQuerySeigniorageProceeds(t *testing.T) {
 
 	require.Equal(t, targetSeigniorage, queriedSeigniorageProceeds)
 }
+
+func getQueriedCurrentEpoch(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) int64 {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCurrentEpoch}, "/"),
+		Data: nil,
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCurrentEpoch}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var response int64
+	err2 := cdc.UnmarshalJSON(bz, &response)
+	require.Nil(t, err2)
+
+	return response
+}
+
+func TestQueryCurrentEpoch(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * 3)
+
+	queriedCurrentEpoch := getQueriedCurrentEpoch(t, input.Ctx, input.Cdc, querier)
+
+	require.Equal(t, int64(3), queriedCurrentEpoch)
+}
+
+func TestQueryIndicators(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	// The policy is updated from epoch 2 on
+	usedIndicators := make(map[int64]types.PolicyIndicators)
+	for epoch := int64(0); epoch < 4; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(100*(epoch+1)))
+		input.TreasuryKeeper.SetSR(input.Ctx, epoch, sdk.NewDec(10*(epoch+1)))
+		input.TreasuryKeeper.SetTSL(input.Ctx, epoch, sdk.NewInt(1000000))
+
+		if epoch >= 2 {
+			ctx := input.Ctx.WithBlockHeight(core.BlocksPerEpoch * epoch)
+			params := input.TreasuryKeeper.GetParams(ctx)
+			input.TreasuryKeeper.UpdateTaxPolicy(ctx)
+			input.TreasuryKeeper.UpdateRewardPolicy(ctx)
+
+			usedIndicators[epoch] = types.NewPolicyIndicators(
+				input.TreasuryKeeper.rollingAverageIndicator(ctx, params.WindowLong, TRL),
+				input.TreasuryKeeper.rollingAverageIndicator(ctx, params.WindowShort, TRL),
+				input.TreasuryKeeper.sumIndicator(ctx, params.WindowShort, SR),
+				input.TreasuryKeeper.sumIndicator(ctx, params.WindowShort, MR),
+			)
+		}
+	}
+
+	// Changed windows do not alter the values the past policy updates used
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.WindowShort = 1
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * 3)
+
+	bz, err := input.Cdc.MarshalJSON(types.NewQueryIndicatorsParams(1, 3))
+	require.NoError(t, err)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryIndicators}, "/"),
+		Data: bz,
+	}
+
+	res, sdkErr := querier(input.Ctx, []string{types.QueryIndicators}, query)
+	require.Nil(t, sdkErr)
+
+	var indicators types.EpochIndicatorsList
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &indicators))
+	require.Equal(t, 3, len(indicators))
+
+	for i, ei := range indicators {
+		epoch := int64(i + 1)
+		require.Equal(t, epoch, ei.Epoch)
+		require.Equal(t, input.TreasuryKeeper.GetTR(input.Ctx, epoch), ei.TR)
+		require.Equal(t, input.TreasuryKeeper.GetSR(input.Ctx, epoch), ei.SR)
+		require.Equal(t, input.TreasuryKeeper.GetTSL(input.Ctx, epoch), ei.TSL)
+		require.Equal(t, MR(input.Ctx, epoch, input.TreasuryKeeper), ei.MR)
+		require.Equal(t, TRL(input.Ctx, epoch, input.TreasuryKeeper), ei.TRL)
+
+		used, updated := usedIndicators[epoch]
+		if !updated {
+			require.Nil(t, ei.Policy)
+			continue
+		}
+
+		require.NotNil(t, ei.Policy)
+		require.Equal(t, used, *ei.Policy)
+	}
+	require.NotEqual(t, input.TreasuryKeeper.sumIndicator(input.Ctx, params.WindowShort, MR), indicators[2].Policy.MRSum)
+
+	// Future epoch is not allowed
+	bz, err = input.Cdc.MarshalJSON(types.NewQueryIndicatorsParams(1, 4))
+	require.NoError(t, err)
+	query.Data = bz
+	_, sdkErr = querier(input.Ctx, []string{types.QueryIndicators}, query)
+	require.Error(t, sdkErr)
+
+	// Pruned epochs are not allowed
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * (params.WindowLong + 1))
+	bz, err = input.Cdc.MarshalJSON(types.NewQueryIndicatorsParams(1, 3))
+	require.NoError(t, err)
+	query.Data = bz
+	_, sdkErr = querier(input.Ctx, []string{types.QueryIndicators}, query)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodePrunedEpoch, sdkErr.Code())
+
+	bz, err = input.Cdc.MarshalJSON(types.NewQueryIndicatorsParams(2, 3))
+	require.NoError(t, err)
+	query.Data = bz
+	_, sdkErr = querier(input.Ctx, []string{types.QueryIndicators}, query)
+	require.Nil(t, sdkErr)
+}
+
+func TestQueryProjectPolicy(t *testing.T) {
+	input, _ := setupValidators(t)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	TSL := sdk.NewInt(1000000)
+	bz, err := input.Cdc.MarshalJSON(types.NewQueryProjectPolicyParams(nil, nil, nil, &TSL))
+	require.NoError(t, err)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryProjectPolicy}, "/"),
+		Data: bz,
+	}
+
+	res, sdkErr := querier(input.Ctx, []string{types.QueryProjectPolicy}, query)
+	require.Nil(t, sdkErr)
+
+	var projection types.PolicyProjection
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &projection))
+	require.Equal(t, TSL, projection.Indicators.TSL)
+	expected := input.TreasuryKeeper.ProjectPolicy(input.Ctx, types.NewQueryProjectPolicyParams(nil, nil, nil, &TSL))
+	require.Equal(t, expected.TaxRate, projection.TaxRate)
+	require.Equal(t, expected.RewardWeight, projection.RewardWeight)
+
+	// Invalid params are rejected
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.TaxPolicy.RateMin = sdk.NewDec(-1)
+	query.Data, err = input.Cdc.MarshalJSON(types.NewQueryProjectPolicyParams(&params, nil, nil, nil))
+	require.NoError(t, err)
+
+	_, sdkErr = querier(input.Ctx, []string{types.QueryProjectPolicy}, query)
+	require.Error(t, sdkErr)
+}
+
+func TestQueryTaxPayments(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1000))
+	input.TreasuryKeeper.SetTaxPayment(input.Ctx, types.NewTaxPayment(1, Addrs[0], taxes))
+	input.TreasuryKeeper.SetTaxPayment(input.Ctx, types.NewTaxPayment(3, Addrs[0], taxes))
+	input.TreasuryKeeper.SetTaxPayment(input.Ctx, types.NewTaxPayment(3, Addrs[1], taxes))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * 3)
+
+	bz, err := input.Cdc.MarshalJSON(types.NewQueryTaxPaymentsParams(Addrs[0], 0, 3))
+	require.NoError(t, err)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTaxPayments}, "/"),
+		Data: bz,
+	}
+
+	res, sdkErr := querier(input.Ctx, []string{types.QueryTaxPayments}, query)
+	require.Nil(t, sdkErr)
+
+	var payments types.TaxPayments
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &payments))
+	require.Equal(t, types.TaxPayments{
+		types.NewTaxPayment(1, Addrs[0], taxes),
+		types.NewTaxPayment(3, Addrs[0], taxes),
+	}, payments)
+
+	// Future epoch is not allowed
+	bz, err = input.Cdc.MarshalJSON(types.NewQueryTaxPaymentsParams(Addrs[0], 0, 4))
+	require.NoError(t, err)
+	query.Data = bz
+	_, sdkErr = querier(input.Ctx, []string{types.QueryTaxPayments}, query)
+	require.Error(t, sdkErr)
+
+	// Empty payer is not allowed
+	bz, err = input.Cdc.MarshalJSON(types.NewQueryTaxPaymentsParams(sdk.AccAddress{}, 0, 3))
+	require.NoError(t, err)
+	query.Data = bz
+	_, sdkErr = querier(input.Ctx, []string{types.QueryTaxPayments}, query)
+	require.Error(t, sdkErr)
+}
@@ -8,9 +8,16 @@ import (
 	core "github.com/terra-project/core/types"
 )
 
-// SettleSeigniorage computes seigniorage and distributes it to oracle and distribution(community-pool) account
+// SettleSeigniorage computes seigniorage and distributes it to oracle and the seigniorage routes
 func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
-	// Mint seigniorage for oracle and community pool
+	settlement := types.SeigniorageSettlement{
+		Epoch:     k.GetEpoch(ctx),
+		Amount:    sdk.Coins{},
+		Transfers: []types.SeigniorageTransfer{},
+	}
+	defer func() { k.SetSeigniorageSettlement(ctx, settlement) }()
+
+	// Mint seigniorage for oracle and seigniorage routes
 	seigniorageLunaAmt := k.PeekEpochSeigniorage(ctx)
 	if seigniorageLunaAmt.LTE(sdk.ZeroInt()) {
 		return
@@ -30,6 +37,7 @@ func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
 		panic(err)
 	}
 	seigniorageAmt := seigniorageCoin.Amount
+	settlement.Amount = seigniorageCoins
 
 	// Send reward to oracle module
 	oracleRewardAmt := rewardWeight.MulInt(seigniorageAmt).TruncateInt()
@@ -38,17 +46,82 @@ func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
 	if err != nil {
 		panic(err)
 	}
+	settlement.Transfers = append(settlement.Transfers,
+		k.recordSeigniorageTransfer(ctx, k.supplyKeeper.GetModuleAddress(k.oracleModuleName), oracleRewardCoins))
+
+	// The routes are validated in genesis and in param change proposals
+	routes := k.SeigniorageRoutes(ctx)
 
-	// Send left to distribution module
+	// Send left to the seigniorage routes; the last route takes the truncated remainder
 	leftAmt := seigniorageAmt.Sub(oracleRewardAmt)
-	leftCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, leftAmt))
-	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distributionModuleName, leftCoins)
+	routedAmt := sdk.ZeroInt()
+	for i, route := range routes {
+		routeAmt := route.Weight.MulInt(leftAmt).TruncateInt()
+		if i == len(routes)-1 {
+			routeAmt = leftAmt.Sub(routedAmt)
+		}
+		routedAmt = routedAmt.Add(routeAmt)
+
+		routeCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, routeAmt))
+		if routeCoins.Empty() {
+			continue
+		}
+
+		k.sendSeigniorage(ctx, route.Address, routeCoins)
+		settlement.Transfers = append(settlement.Transfers, k.recordSeigniorageTransfer(ctx, route.Address, routeCoins))
+	}
+}
+
+// sendSeigniorage sends the seigniorage to the recipient; seigniorage sent to the
+// distribution module account is added to the community pool
+func (k Keeper) sendSeigniorage(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) {
+	if !recipient.Equals(k.supplyKeeper.GetModuleAddress(k.distributionModuleName)) {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+		if err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distributionModuleName, coins)
 	if err != nil {
 		panic(err)
 	}
 
 	// Update distribution community pool
 	feePool := k.distrKeeper.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(leftCoins))
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(coins))
 	k.distrKeeper.SetFeePool(ctx, feePool)
 }
+
+// recordSeigniorageTransfer emits the event of a seigniorage transfer and returns its record
+func (k Keeper) recordSeigniorageTransfer(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) types.SeigniorageTransfer {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSeigniorageTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return types.NewSeigniorageTransfer(recipient, coins)
+}
+
+// GetSeigniorageSettlement returns the breakdown of the last seigniorage settlement
+func (k Keeper) GetSeigniorageSettlement(ctx sdk.Context) (settlement types.SeigniorageSettlement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SeigniorageSettlementKey)
+	if bz == nil {
+		return types.SeigniorageSettlement{Amount: sdk.Coins{}, Transfers: []types.SeigniorageTransfer{}}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &settlement)
+	return
+}
+
+// SetSeigniorageSettlement stores the breakdown of the last seigniorage settlement
+func (k Keeper) SetSeigniorageSettlement(ctx sdk.Context, settlement types.SeigniorageSettlement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(settlement)
+	store.Set(types.SeigniorageSettlementKey, bz)
+}
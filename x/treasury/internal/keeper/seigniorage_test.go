@@ -9,6 +9,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/treasury/internal/types"
 )
 
 func TestSettle(t *testing.T) {
@@ -37,4 +41,50 @@ func TestSettle(t *testing.T) {
 
 	require.Equal(t, oracleRewardAmt, oracleAcc.GetCoins().AmountOf(core.MicroLunaDenom))
 	require.Equal(t, leftAmt, feePool.CommunityPool.AmountOf(core.MicroLunaDenom).TruncateInt())
+
+	settlement := input.TreasuryKeeper.GetSeigniorageSettlement(input.Ctx)
+	require.Equal(t, int64(1), settlement.Epoch)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, issuance)), settlement.Amount)
+	require.Equal(t, []types.SeigniorageTransfer{
+		types.NewSeigniorageTransfer(oracleAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, oracleRewardAmt))),
+		types.NewSeigniorageTransfer(input.SupplyKeeper.GetModuleAddress(distr.ModuleName), sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, leftAmt))),
+	}, settlement.Transfers)
+}
+
+func TestSettleSeigniorageRoutes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.SeigniorageRoutes = types.SeigniorageRoutes{
+		types.NewSeigniorageRoute(input.SupplyKeeper.GetModuleAddress(market.ModuleName), sdk.NewDecWithPrec(3, 1)),
+		types.NewSeigniorageRoute(input.SupplyKeeper.GetModuleAddress(distr.ModuleName), sdk.NewDecWithPrec(7, 1)),
+	}
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	issuance := sdk.NewInt(1000001)
+	supply := input.SupplyKeeper.GetSupply(input.Ctx)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, issuance)))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt())))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+
+	input.TreasuryKeeper.SettleSeigniorage(input.Ctx)
+
+	rewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
+	oracleRewardAmt := rewardWeight.MulInt(issuance).TruncateInt()
+	leftAmt := issuance.Sub(oracleRewardAmt)
+	marketAmt := sdk.NewDecWithPrec(3, 1).MulInt(leftAmt).TruncateInt()
+
+	// the last route takes the truncated remainder
+	marketAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, market.ModuleName)
+	require.Equal(t, marketAmt, marketAcc.GetCoins().AmountOf(core.MicroLunaDenom))
+	feePool := input.DistrKeeper.GetFeePool(input.Ctx)
+	require.Equal(t, leftAmt.Sub(marketAmt), feePool.CommunityPool.AmountOf(core.MicroLunaDenom).TruncateInt())
+
+	treasuryAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, types.ModuleName)
+	require.True(t, treasuryAcc.GetCoins().Empty())
+	require.Equal(t, 3, len(input.TreasuryKeeper.GetSeigniorageSettlement(input.Ctx).Transfers))
 }
@@ -63,6 +63,7 @@ type TestInput struct {
 	SupplyKeeper   supply.Keeper
 	MarketKeeper   market.Keeper
 	DistrKeeper    distr.Keeper
+	ParamsKeeper   params.Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -121,6 +122,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracle.ModuleName:         true,
 		market.ModuleName:         true,
 		types.ModuleName:          true,
+		types.BurnModuleName:      true,
 	}
 
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, params.DefaultCodespace)
@@ -135,6 +137,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		market.ModuleName:         {supply.Burner, supply.Minter},
 		oracle.ModuleName:         nil,
 		types.ModuleName:          {supply.Minter},
+		types.BurnModuleName:      {supply.Burner},
 	}
 
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
@@ -176,12 +179,14 @@ func CreateTestInput(t *testing.T) TestInput {
 	treasuryKeeper := NewKeeper(
 		cdc,
 		keyTreasury, paramsKeeper.Subspace(types.DefaultParamspace),
-		supplyKeeper, marketKeeper, stakingKeeper, distrKeeper,
+		supplyKeeper, marketKeeper, oracleKeeper, stakingKeeper, distrKeeper,
 		oracle.ModuleName, distr.ModuleName,
 		types.DefaultCodespace,
 	)
 
+	oracleKeeper.SetParams(ctx, oracle.DefaultParams())
 	treasuryKeeper.SetParams(ctx, types.DefaultParams())
+	treasuryKeeper.SetEpochBoundary(ctx, types.NewEpochBoundary(0, 0, types.DefaultEpochLength))
 
 	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName)
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
@@ -210,7 +215,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	stakingKeeper.SetHooks(staking.NewMultiStakingHooks(distrKeeper.Hooks()))
 
-	return TestInput{ctx, cdc, treasuryKeeper, stakingKeeper, oracleKeeper, supplyKeeper, marketKeeper, distrKeeper}
+	return TestInput{ctx, cdc, treasuryKeeper, stakingKeeper, oracleKeeper, supplyKeeper, marketKeeper, distrKeeper, paramsKeeper}
 }
 
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) staking.MsgCreateValidator {
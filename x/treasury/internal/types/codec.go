@@ -8,8 +8,14 @@ import (
 
 // RegisterCodec registers concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgBurn{}, "treasury/MsgBurn", nil)
+
 	cdc.RegisterConcrete(TaxRateUpdateProposal{}, "treasury/TaxRateUpdateProposal", nil)
 	cdc.RegisterConcrete(RewardWeightUpdateProposal{}, "treasury/RewardWeightUpdateProposal", nil)
+	cdc.RegisterConcrete(TaxExemptionAddProposal{}, "treasury/TaxExemptionAddProposal", nil)
+	cdc.RegisterConcrete(TaxExemptionRemoveProposal{}, "treasury/TaxExemptionRemoveProposal", nil)
+	cdc.RegisterConcrete(TaxRateOverrideUpdateProposal{}, "treasury/TaxRateOverrideUpdateProposal", nil)
+	cdc.RegisterConcrete(TaxCapUpdateProposal{}, "treasury/TaxCapUpdateProposal", nil)
 }
 
 // ModuleCdc defines generic sealed codec to be used throughout module
@@ -22,4 +28,8 @@ func init() {
 
 	gov.RegisterProposalTypeCodec(TaxRateUpdateProposal{}, "treasury/TaxRateUpdateProposal")
 	gov.RegisterProposalTypeCodec(RewardWeightUpdateProposal{}, "treasury/RewardWeightUpdateProposal")
+	gov.RegisterProposalTypeCodec(TaxExemptionAddProposal{}, "treasury/TaxExemptionAddProposal")
+	gov.RegisterProposalTypeCodec(TaxExemptionRemoveProposal{}, "treasury/TaxExemptionRemoveProposal")
+	gov.RegisterProposalTypeCodec(TaxRateOverrideUpdateProposal{}, "treasury/TaxRateOverrideUpdateProposal")
+	gov.RegisterProposalTypeCodec(TaxCapUpdateProposal{}, "treasury/TaxCapUpdateProposal")
 }
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EpochBoundary records the block height an epoch-length took effect at,
+// so epochs of the past stay addressable after the epoch-length param changes
+type EpochBoundary struct {
+	Epoch       int64 `json:"epoch" yaml:"epoch"`
+	StartHeight int64 `json:"start_height" yaml:"start_height"`
+	EpochLength int64 `json:"epoch_length" yaml:"epoch_length"`
+}
+
+// NewEpochBoundary creates a new EpochBoundary instance
+func NewEpochBoundary(epoch, startHeight, epochLength int64) EpochBoundary {
+	return EpochBoundary{
+		Epoch:       epoch,
+		StartHeight: startHeight,
+		EpochLength: epochLength,
+	}
+}
+
+// GetEpoch returns the epoch of the given height, which must not precede the boundary
+func (b EpochBoundary) GetEpoch(height int64) int64 {
+	return b.Epoch + (height-b.StartHeight)/b.EpochLength
+}
+
+// IsEpochLastBlock returns true if the given height is the last block of an epoch
+func (b EpochBoundary) IsEpochLastBlock(height int64) bool {
+	return (height+1-b.StartHeight)%b.EpochLength == 0
+}
+
+// String implements fmt.Stringer interface
+func (b EpochBoundary) String() string {
+	return fmt.Sprintf(`EpochBoundary
+	Epoch:       %d
+	StartHeight: %d
+	EpochLength: %d`, b.Epoch, b.StartHeight, b.EpochLength)
+}
+
+// EpochBoundaries is a history of epoch boundaries ordered by epoch
+type EpochBoundaries []EpochBoundary
+
+// Validate checks the history starts at genesis and every boundary lies on
+// the last block of an epoch of the previous boundary
+func (bs EpochBoundaries) Validate() error {
+	for i, b := range bs {
+		if b.EpochLength < MinEpochLength {
+			return fmt.Errorf("epoch-length of epoch %d must be >= %d, is %d", b.Epoch, MinEpochLength, b.EpochLength)
+		}
+
+		if i == 0 {
+			if b.Epoch != 0 || b.StartHeight != 0 {
+				return fmt.Errorf("first epoch boundary must start at epoch 0 and height 0")
+			}
+
+			continue
+		}
+
+		prev := bs[i-1]
+		if b.Epoch <= prev.Epoch {
+			return fmt.Errorf("epoch boundaries must be ordered by epoch, %d follows %d", b.Epoch, prev.Epoch)
+		}
+
+		if b.StartHeight != prev.StartHeight+(b.Epoch-prev.Epoch)*prev.EpochLength {
+			return fmt.Errorf("epoch boundary of epoch %d does not start at the end of epoch %d", b.Epoch, b.Epoch-1)
+		}
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (bs EpochBoundaries) String() (out string) {
+	for _, b := range bs {
+		out += b.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
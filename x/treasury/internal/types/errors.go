@@ -10,7 +10,13 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = "treasury"
 
-	CodeInvalidEpoch sdk.CodeType = 1
+	CodeInvalidEpoch             sdk.CodeType = 1
+	CodeInvalidTaxExemption      sdk.CodeType = 2
+	CodeNoTaxExemption           sdk.CodeType = 3
+	CodeExpiredTaxCapPin         sdk.CodeType = 4
+	CodePrunedEpoch              sdk.CodeType = 5
+	CodeInvalidEpochLength       sdk.CodeType = 6
+	CodeInvalidSeigniorageRoutes sdk.CodeType = 7
 )
 
 // ----------------------------------------
@@ -20,3 +26,33 @@ const (
 func ErrInvalidEpoch(codespace sdk.CodespaceType, curEpoch, epoch int64) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidEpoch, fmt.Sprintf("The query epoch should be between [0, %d] but given %d", curEpoch, epoch))
 }
+
+// ErrPrunedEpoch called when the indicators of the epoch are pruned
+func ErrPrunedEpoch(codespace sdk.CodespaceType, cutoffEpoch, epoch int64) sdk.Error {
+	return sdk.NewError(codespace, CodePrunedEpoch, fmt.Sprintf("The indicators before epoch %d are pruned but given %d", cutoffEpoch, epoch))
+}
+
+// ErrInvalidEpochLength called when the epoch-length does not span whole oracle vote periods
+func ErrInvalidEpochLength(codespace sdk.CodespaceType, epochLength, votePeriod int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEpochLength, fmt.Sprintf("The epoch length should be a multiple of the vote period %d but given %d", votePeriod, epochLength))
+}
+
+// ErrInvalidSeigniorageRoutes called when the seigniorage routes are invalid
+func ErrInvalidSeigniorageRoutes(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSeigniorageRoutes, fmt.Sprintf("Invalid seigniorage routes: %s", msg))
+}
+
+// ErrInvalidTaxExemption called when a tax exemption is invalid
+func ErrInvalidTaxExemption(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTaxExemption, fmt.Sprintf("Invalid tax exemption: %s", msg))
+}
+
+// ErrNoTaxExemption called when the tax exemption to remove is not registered
+func ErrNoTaxExemption(codespace sdk.CodespaceType, address, counterparty sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoTaxExemption, fmt.Sprintf("No tax exemption registered for %s %s", address, counterparty))
+}
+
+// ErrExpiredTaxCapPin called when the expiry epoch of a tax-cap pin has already passed
+func ErrExpiredTaxCapPin(codespace sdk.CodespaceType, curEpoch, expiryEpoch int64) sdk.Error {
+	return sdk.NewError(codespace, CodeExpiredTaxCapPin, fmt.Sprintf("The expiry epoch should be 0 or >= %d but given %d", curEpoch, expiryEpoch))
+}
@@ -9,3 +9,20 @@ const (
 	AttributeKeyRewardWeight = "reward_weight"
 	AttributeKeyTaxCap       = "tax_cap"
 )
+
+// Treasury module seigniorage event types
+const (
+	EventTypeSeigniorageTransfer = "seigniorage_transfer"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
+
+// Treasury module burn event types
+const (
+	EventTypeBurn = "burn"
+
+	AttributeKeyBurner = "burner"
+
+	AttributeValueCategory = ModuleName
+)
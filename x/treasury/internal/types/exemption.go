@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TaxExemption exempts transfers from the stability tax.
+// Without a Counterparty, every transfer sent from Address is exempt;
+// with a Counterparty, only transfers between Address and Counterparty (in both directions) are exempt.
+// An empty Denoms list exempts every denom, otherwise only the listed denoms are exempt.
+type TaxExemption struct {
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	Counterparty sdk.AccAddress `json:"counterparty" yaml:"counterparty"`
+	Denoms       []string       `json:"denoms" yaml:"denoms"`
+}
+
+// NewTaxExemption creates a TaxExemption instance
+func NewTaxExemption(address, counterparty sdk.AccAddress, denoms []string) TaxExemption {
+	return TaxExemption{
+		Address:      address,
+		Counterparty: counterparty,
+		Denoms:       denoms,
+	}
+}
+
+// Covers returns true if the exemption applies to the denom
+func (te TaxExemption) Covers(denom string) bool {
+	if len(te.Denoms) == 0 {
+		return true
+	}
+
+	for _, d := range te.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if the exemption applies to a transfer from sender to recipient.
+// recipient can be empty to match only exemptions without counterparty.
+func (te TaxExemption) Matches(sender, recipient sdk.AccAddress) bool {
+	if te.Counterparty.Empty() {
+		return te.Address.Equals(sender)
+	}
+
+	if recipient.Empty() {
+		return false
+	}
+
+	return (te.Address.Equals(sender) && te.Counterparty.Equals(recipient)) ||
+		(te.Address.Equals(recipient) && te.Counterparty.Equals(sender))
+}
+
+// Key returns the store key of the exemption
+func (te TaxExemption) Key() []byte {
+	return GetTaxExemptionKey(te.Address, te.Counterparty)
+}
+
+// Validate performs basic validation of the exemption
+func (te TaxExemption) Validate() error {
+	if te.Address.Empty() {
+		return fmt.Errorf("tax exemption address cannot be empty")
+	}
+
+	if te.Address.Equals(te.Counterparty) {
+		return fmt.Errorf("tax exemption counterparty cannot be the address itself: %s", te.Address)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range te.Denoms {
+		if len(denom) == 0 {
+			return fmt.Errorf("tax exemption denom cannot be empty")
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicated denom in tax exemption: %s", denom)
+		}
+
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (te TaxExemption) String() string {
+	return fmt.Sprintf(`TaxExemption:
+  Address:      %s
+  Counterparty: %s
+  Denoms:       %s`, te.Address, te.Counterparty, strings.Join(te.Denoms, ","))
+}
+
+// TaxExemptions is a collection of TaxExemption
+type TaxExemptions []TaxExemption
+
+// IsExempt returns true if any of the exemptions applies to a transfer of
+// denom from sender to recipient
+func (tes TaxExemptions) IsExempt(sender, recipient sdk.AccAddress, denom string) bool {
+	for _, te := range tes {
+		if te.Matches(sender, recipient) && te.Covers(denom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs basic validation of the exemptions and checks for duplicates
+func (tes TaxExemptions) Validate() error {
+	seen := make(map[string]bool)
+	for _, te := range tes {
+		if err := te.Validate(); err != nil {
+			return err
+		}
+
+		key := string(te.Key())
+		if seen[key] {
+			return fmt.Errorf("duplicated tax exemption: %s %s", te.Address, te.Counterparty)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (tes TaxExemptions) String() (out string) {
+	for _, te := range tes {
+		out += te.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
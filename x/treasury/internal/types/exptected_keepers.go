@@ -8,9 +8,19 @@ import (
 
 // SupplyKeeper expected supply keeper
 type SupplyKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
 	GetSupply(ctx sdk.Context) (supply supplyexported.SupplyI)
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// TreasuryHooks event hooks for the treasury epoch boundary
+type TreasuryHooks interface {
+	BeforeEpochEnd(ctx sdk.Context, epoch int64)                                                 // Must be called at the last block of an epoch, before the indicators are updated
+	AfterPolicyUpdate(ctx sdk.Context, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins) // Must be called when the policy of the next epoch is updated
 }
 
 // MarketKeeper expected market keeper
@@ -18,6 +28,11 @@ type MarketKeeper interface {
 	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, sdk.Error)
 }
 
+// OracleKeeper expected oracle keeper
+type OracleKeeper interface {
+	VotePeriod(ctx sdk.Context) (res int64)
+}
+
 // StakingKeeper expected keeper for staking module
 type StakingKeeper interface {
 	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
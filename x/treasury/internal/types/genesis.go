@@ -9,21 +9,33 @@ import (
 
 // GenesisState - all treasury state that must be provided at genesis
 type GenesisState struct {
-	Params               Params             `json:"params" yaml:"params"` // market params
-	TaxRate              sdk.Dec            `json:"tax_rate" yaml:"tax_rate"`
-	RewardWeight         sdk.Dec            `json:"reward_weight" yaml:"reward_weight"`
-	TaxCaps              map[string]sdk.Int `json:"tax_caps" yaml:"tax_caps"`
-	TaxProceed           sdk.Coins          `json:"tax_proceed" yaml:"tax_proceed"`
-	EpochInitialIssuance sdk.Coins          `json:"epoch_initial_issuance" yaml:"epoch_initial_issuance"`
-	TRs                  []sdk.Dec          `json:"TRs" yaml:"TRs"`
-	SRs                  []sdk.Dec          `json:"SRs" yaml:"SRs"`
-	TSLs                 []sdk.Int          `json:"TSLs" yaml:"TSLs"`
+	Params               Params                  `json:"params" yaml:"params"` // market params
+	TaxRate              sdk.Dec                 `json:"tax_rate" yaml:"tax_rate"`
+	RewardWeight         sdk.Dec                 `json:"reward_weight" yaml:"reward_weight"`
+	TaxCaps              map[string]sdk.Int      `json:"tax_caps" yaml:"tax_caps"`
+	TaxProceed           sdk.Coins               `json:"tax_proceed" yaml:"tax_proceed"`
+	EpochInitialIssuance sdk.Coins               `json:"epoch_initial_issuance" yaml:"epoch_initial_issuance"`
+	TRs                  []EpochDec              `json:"TRs" yaml:"TRs"`
+	SRs                  []EpochDec              `json:"SRs" yaml:"SRs"`
+	TSLs                 []EpochInt              `json:"TSLs" yaml:"TSLs"`
+	PolicyIndicators     []EpochPolicyIndicators `json:"policy_indicators" yaml:"policy_indicators"`
+	TaxExemptions        TaxExemptions           `json:"tax_exemptions" yaml:"tax_exemptions"`
+	TaxRateOverrides     TaxRateOverrides        `json:"tax_rate_overrides" yaml:"tax_rate_overrides"`
+	EpochBoundaries      EpochBoundaries         `json:"epoch_boundaries" yaml:"epoch_boundaries"`
+	TaxCapPins           TaxCapPins              `json:"tax_cap_pins" yaml:"tax_cap_pins"`
+	TaxPayments          TaxPayments             `json:"tax_payments" yaml:"tax_payments"`
+	TotalBurns           sdk.Coins               `json:"total_burns" yaml:"total_burns"`
+	EpochBurns           sdk.Coins               `json:"epoch_burns" yaml:"epoch_burns"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, taxRate sdk.Dec, rewardWeight sdk.Dec,
 	taxCaps map[string]sdk.Int, taxProceed sdk.Coins,
-	epochInitialIssuance sdk.Coins, TRs []sdk.Dec, SRs []sdk.Dec, TSLs []sdk.Int) GenesisState {
+	epochInitialIssuance sdk.Coins, TRs []EpochDec, SRs []EpochDec, TSLs []EpochInt,
+	policyIndicators []EpochPolicyIndicators,
+	taxExemptions TaxExemptions, taxRateOverrides TaxRateOverrides,
+	epochBoundaries EpochBoundaries, taxCapPins TaxCapPins, taxPayments TaxPayments,
+	totalBurns sdk.Coins, epochBurns sdk.Coins) GenesisState {
 	return GenesisState{
 		Params:               params,
 		TaxRate:              taxRate,
@@ -34,6 +46,14 @@ func NewGenesisState(params Params, taxRate sdk.Dec, rewardWeight sdk.Dec,
 		TRs:                  TRs,
 		SRs:                  SRs,
 		TSLs:                 TSLs,
+		PolicyIndicators:     policyIndicators,
+		TaxExemptions:        taxExemptions,
+		TaxRateOverrides:     taxRateOverrides,
+		EpochBoundaries:      epochBoundaries,
+		TaxCapPins:           taxCapPins,
+		TaxPayments:          taxPayments,
+		TotalBurns:           totalBurns,
+		EpochBurns:           epochBurns,
 	}
 }
 
@@ -46,9 +66,17 @@ func DefaultGenesisState() GenesisState {
 		TaxCaps:              make(map[string]sdk.Int),
 		TaxProceed:           sdk.Coins{},
 		EpochInitialIssuance: sdk.Coins{},
-		TRs:                  []sdk.Dec{},
-		SRs:                  []sdk.Dec{},
-		TSLs:                 []sdk.Int{},
+		TRs:                  []EpochDec{},
+		SRs:                  []EpochDec{},
+		TSLs:                 []EpochInt{},
+		PolicyIndicators:     []EpochPolicyIndicators{},
+		TaxExemptions:        TaxExemptions{},
+		TaxRateOverrides:     TaxRateOverrides{},
+		EpochBoundaries:      EpochBoundaries{},
+		TaxCapPins:           TaxCapPins{},
+		TaxPayments:          TaxPayments{},
+		TotalBurns:           sdk.Coins{},
+		EpochBurns:           sdk.Coins{},
 	}
 }
 
@@ -64,6 +92,70 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("reward-weight must less than WeightMax(%s) and bigger than RateMin(%s)", data.Params.RewardPolicy.RateMax, data.Params.RewardPolicy.RateMin)
 	}
 
+	if err := data.TaxExemptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.TaxRateOverrides.Validate(); err != nil {
+		return err
+	}
+
+	for _, override := range data.TaxRateOverrides {
+		if override.TaxRate.LT(data.Params.TaxPolicy.RateMin) || override.TaxRate.GT(data.Params.TaxPolicy.RateMax) {
+			return fmt.Errorf("tax-rate of %s must less than RateMax(%s) and bigger than RateMin(%s)", override.Denom, data.Params.TaxPolicy.RateMax, data.Params.TaxPolicy.RateMin)
+		}
+	}
+
+	var TREpochs, SREpochs, TSLEpochs, policyEpochs []int64
+	for _, TR := range data.TRs {
+		TREpochs = append(TREpochs, TR.Epoch)
+	}
+	for _, SR := range data.SRs {
+		SREpochs = append(SREpochs, SR.Epoch)
+	}
+	for _, TSL := range data.TSLs {
+		TSLEpochs = append(TSLEpochs, TSL.Epoch)
+	}
+	for _, indicators := range data.PolicyIndicators {
+		policyEpochs = append(policyEpochs, indicators.Epoch)
+	}
+
+	if err := validateIndicatorEpochs("TR", TREpochs); err != nil {
+		return err
+	}
+
+	if err := validateIndicatorEpochs("SR", SREpochs); err != nil {
+		return err
+	}
+
+	if err := validateIndicatorEpochs("TSL", TSLEpochs); err != nil {
+		return err
+	}
+
+	if err := validateIndicatorEpochs("policy indicators", policyEpochs); err != nil {
+		return err
+	}
+
+	if err := data.TaxCapPins.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.EpochBoundaries.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.TaxPayments.Validate(); err != nil {
+		return err
+	}
+
+	if !data.TotalBurns.IsValid() || !data.EpochBurns.IsValid() {
+		return fmt.Errorf("invalid burns: total %s, epoch %s", data.TotalBurns, data.EpochBurns)
+	}
+
+	if !data.TotalBurns.IsAllGTE(data.EpochBurns) {
+		return fmt.Errorf("epoch burns %s cannot exceed total burns %s", data.EpochBurns, data.TotalBurns)
+	}
+
 	return data.Params.Validate()
 }
 
@@ -23,6 +23,98 @@ func TestGenesisValidation(t *testing.T) {
 	// Error - reward-weight range error
 	genState.RewardWeight = sdk.NewDec(-1)
 	require.Error(t, ValidateGenesis(genState))
+
+	// Error - tax-rate override range error
+	genState = DefaultGenesisState()
+	genState.TaxRateOverrides = TaxRateOverrides{NewTaxRateOverride("ukrw", sdk.NewDecWithPrec(5, 1))}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - duplicated tax-rate override
+	genState.TaxRateOverrides = TaxRateOverrides{
+		NewTaxRateOverride("ukrw", sdk.NewDecWithPrec(5, 3)),
+		NewTaxRateOverride("ukrw", sdk.NewDecWithPrec(1, 3)),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.TaxRateOverrides = genState.TaxRateOverrides[:1]
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicated tax-cap pin
+	genState = DefaultGenesisState()
+	genState.TaxCapPins = TaxCapPins{
+		NewTaxCapPin("ukrw", sdk.NewInt(1000), 0),
+		NewTaxCapPin("ukrw", sdk.NewInt(2000), 10),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.TaxCapPins = genState.TaxCapPins[:1]
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - epoch boundary history not starting at genesis
+	genState = DefaultGenesisState()
+	genState.EpochBoundaries = EpochBoundaries{NewEpochBoundary(1, 100, 100)}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - epoch boundary not at the end of an epoch
+	genState.EpochBoundaries = EpochBoundaries{
+		NewEpochBoundary(0, 0, 100),
+		NewEpochBoundary(2, 150, 100),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - epoch-length too short
+	genState.EpochBoundaries = EpochBoundaries{
+		NewEpochBoundary(0, 0, 100),
+		NewEpochBoundary(2, 200, MinEpochLength-1),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.EpochBoundaries = EpochBoundaries{
+		NewEpochBoundary(0, 0, 100),
+		NewEpochBoundary(2, 200, 50),
+	}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - negative indicator epoch
+	genState = DefaultGenesisState()
+	genState.TRs = []EpochDec{NewEpochDec(-1, sdk.NewDec(100))}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - duplicated indicator epoch
+	genState.TRs = []EpochDec{NewEpochDec(60, sdk.NewDec(100))}
+	genState.TSLs = []EpochInt{NewEpochInt(60, sdk.NewInt(100)), NewEpochInt(60, sdk.NewInt(200))}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.TSLs = genState.TSLs[:1]
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicated tax payment
+	payer := sdk.AccAddress([]byte("addr1_______________"))
+	taxes := sdk.NewCoins(sdk.NewInt64Coin("ukrw", 1000))
+	genState = DefaultGenesisState()
+	genState.TaxPayments = TaxPayments{NewTaxPayment(1, payer, taxes), NewTaxPayment(1, payer, taxes)}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - empty tax payment
+	genState.TaxPayments = TaxPayments{NewTaxPayment(1, payer, sdk.Coins{})}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.TaxPayments = TaxPayments{NewTaxPayment(1, payer, taxes), NewTaxPayment(2, payer, taxes)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - epoch burns exceeding total burns
+	genState = DefaultGenesisState()
+	genState.EpochBurns = taxes
+	require.Error(t, ValidateGenesis(genState))
+
+	// Valid
+	genState.TotalBurns = taxes
+	require.NoError(t, ValidateGenesis(genState))
 }
 
 func TestGenesisEqual(t *testing.T) {
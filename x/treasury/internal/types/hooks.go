@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiTreasuryHooks combines multiple treasury hooks, all hook functions are run in array sequence
+type MultiTreasuryHooks []TreasuryHooks
+
+// NewMultiTreasuryHooks creates a MultiTreasuryHooks instance
+func NewMultiTreasuryHooks(hooks ...TreasuryHooks) MultiTreasuryHooks {
+	return hooks
+}
+
+// BeforeEpochEnd runs the BeforeEpochEnd hook of every hooks
+func (h MultiTreasuryHooks) BeforeEpochEnd(ctx sdk.Context, epoch int64) {
+	for i := range h {
+		h[i].BeforeEpochEnd(ctx, epoch)
+	}
+}
+
+// AfterPolicyUpdate runs the AfterPolicyUpdate hook of every hooks
+func (h MultiTreasuryHooks) AfterPolicyUpdate(ctx sdk.Context, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins) {
+	for i := range h {
+		h[i].AfterPolicyUpdate(ctx, taxRate, rewardWeight, taxCaps)
+	}
+}
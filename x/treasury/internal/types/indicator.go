@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochIndicators holds the indicators recorded for an epoch and
+// the rolling values which the policy update of the epoch computed from them
+type EpochIndicators struct {
+	Epoch int64   `json:"epoch" yaml:"epoch"`
+	TR    sdk.Dec `json:"TR" yaml:"TR"`   // Tax Rewards
+	SR    sdk.Dec `json:"SR" yaml:"SR"`   // Seigniorage Rewards
+	TSL   sdk.Int `json:"TSL" yaml:"TSL"` // Total Staked Luna
+	MR    sdk.Dec `json:"MR" yaml:"MR"`   // Mining Rewards (TR + SR)
+	TRL   sdk.Dec `json:"TRL" yaml:"TRL"` // Tax Rewards per Luna (TR / TSL)
+
+	// nil when the policy was not updated at the epoch, i.e. during the probation period
+	Policy *PolicyIndicators `json:"policy,omitempty" yaml:"policy,omitempty"`
+}
+
+// String implements fmt.Stringer interface
+func (ei EpochIndicators) String() string {
+	out := fmt.Sprintf(`EpochIndicators:
+  Epoch:    %d
+  TR:       %s
+  SR:       %s
+  TSL:      %s
+  MR:       %s
+  TRL:      %s`,
+		ei.Epoch, ei.TR, ei.SR, ei.TSL, ei.MR, ei.TRL)
+
+	if ei.Policy != nil {
+		out += fmt.Sprintf(`
+  TRLYear:  %s
+  TRLMonth: %s
+  SRSum:    %s
+  MRSum:    %s`,
+			ei.Policy.TRLYear, ei.Policy.TRLMonth, ei.Policy.SRSum, ei.Policy.MRSum)
+	}
+
+	return out
+}
+
+// PolicyIndicators holds the rolling values the tax and reward policy updates
+// computed at an epoch, with the windows in effect at that epoch
+type PolicyIndicators struct {
+	TRLYear  sdk.Dec `json:"TRL_year" yaml:"TRL_year"`   // rolling average of TRL over WindowLong; used by tax policy
+	TRLMonth sdk.Dec `json:"TRL_month" yaml:"TRL_month"` // rolling average of TRL over WindowShort; used by tax policy
+	SRSum    sdk.Dec `json:"SR_sum" yaml:"SR_sum"`       // sum of SR over WindowShort; used by reward policy
+	MRSum    sdk.Dec `json:"MR_sum" yaml:"MR_sum"`       // sum of MR over WindowShort; used by reward policy
+}
+
+// NewPolicyIndicators creates a PolicyIndicators instance
+func NewPolicyIndicators(TRLYear, TRLMonth, SRSum, MRSum sdk.Dec) PolicyIndicators {
+	return PolicyIndicators{
+		TRLYear:  TRLYear,
+		TRLMonth: TRLMonth,
+		SRSum:    SRSum,
+		MRSum:    MRSum,
+	}
+}
+
+// EpochPolicyIndicators is a PolicyIndicators recorded for an epoch
+type EpochPolicyIndicators struct {
+	Epoch      int64            `json:"epoch" yaml:"epoch"`
+	Indicators PolicyIndicators `json:"indicators" yaml:"indicators"`
+}
+
+// NewEpochPolicyIndicators creates an EpochPolicyIndicators instance
+func NewEpochPolicyIndicators(epoch int64, indicators PolicyIndicators) EpochPolicyIndicators {
+	return EpochPolicyIndicators{
+		Epoch:      epoch,
+		Indicators: indicators,
+	}
+}
+
+// EpochIndicatorsList is a collection of EpochIndicators
+type EpochIndicatorsList []EpochIndicators
+
+// String implements fmt.Stringer interface
+func (l EpochIndicatorsList) String() (out string) {
+	for _, val := range l {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// EpochDec is a sdk.Dec indicator recorded for an epoch
+type EpochDec struct {
+	Epoch  int64   `json:"epoch" yaml:"epoch"`
+	Amount sdk.Dec `json:"amount" yaml:"amount"`
+}
+
+// NewEpochDec creates an EpochDec instance
+func NewEpochDec(epoch int64, amount sdk.Dec) EpochDec {
+	return EpochDec{
+		Epoch:  epoch,
+		Amount: amount,
+	}
+}
+
+// EpochInt is a sdk.Int indicator recorded for an epoch
+type EpochInt struct {
+	Epoch  int64   `json:"epoch" yaml:"epoch"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// NewEpochInt creates an EpochInt instance
+func NewEpochInt(epoch int64, amount sdk.Int) EpochInt {
+	return EpochInt{
+		Epoch:  epoch,
+		Amount: amount,
+	}
+}
+
+// validateIndicatorEpochs checks the epochs of recorded indicators are non-negative and distinct
+func validateIndicatorEpochs(name string, epochs []int64) error {
+	seen := make(map[int64]bool)
+	for _, epoch := range epochs {
+		if epoch < 0 {
+			return fmt.Errorf("%s epoch must be >= 0, is %d", name, epoch)
+		}
+
+		if seen[epoch] {
+			return fmt.Errorf("duplicated %s for epoch %d", name, epoch)
+		}
+
+		seen[epoch] = true
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -16,6 +19,9 @@ const (
 
 	// QuerierRoute is the querier route for treasury
 	QuerierRoute = ModuleName
+
+	// BurnModuleName is the name of the module account voluntary burns go through
+	BurnModuleName = "burn"
 )
 
 // Keys for treasury store
@@ -36,6 +42,24 @@ const (
 // - 0x07<epoch_Bytes>: sdk.Dec
 //
 // - 0x08<epoch_Bytes>: sdk.Int
+//
+// - 0x09<address_Bytes>[<counterparty_Bytes>]: TaxExemption
+//
+// - 0x0a<denom_Bytes>: sdk.Dec
+//
+// - 0x0b<epoch_Bytes>: EpochBoundary
+//
+// - 0x0c: SeigniorageSettlement
+//
+// - 0x0d<denom_Bytes>: TaxCapPin
+//
+// - 0x0e<epoch_Bytes><address_Bytes><denom_Bytes>: sdk.Int
+//
+// - 0x0f: sdk.Coins
+//
+// - 0x10: sdk.Coins
+//
+// - 0x11<epoch_Bytes>: PolicyIndicators
 var (
 	// Keys for store prefixes
 	TaxRateKey              = []byte{0x01} // a key for a tax-rate
@@ -48,6 +72,31 @@ var (
 	TRKey  = []byte{0x06} // prefix for each key to a TR
 	SRKey  = []byte{0x07} // prefix for each key to a SR
 	TSLKey = []byte{0x08} // prefix for each key to a TSL
+
+	// Keys for store prefixes of tax exemptions
+	TaxExemptionKey = []byte{0x09} // prefix for each key to a tax exemption
+
+	// Keys for store prefixes of per-denom tax-rates
+	TaxRateOverrideKey = []byte{0x0a} // prefix for each key to a tax-rate override
+
+	// Keys for store prefixes of epoch-length history
+	EpochBoundaryKey = []byte{0x0b} // prefix for each key to an epoch boundary
+
+	// Keys for the seigniorage settlement record
+	SeigniorageSettlementKey = []byte{0x0c} // a key for the last seigniorage settlement
+
+	// Keys for store prefixes of governance pinned tax-caps
+	TaxCapPinKey = []byte{0x0d} // prefix for each key to a tax-cap pin
+
+	// Keys for store prefixes of the per-address tax payment index
+	TaxPaymentKey = []byte{0x0e} // prefix for each key to a tax payment
+
+	// Keys for voluntary burns
+	TotalBurnsKey = []byte{0x0f} // a key for the cumulative voluntary burns
+	EpochBurnsKey = []byte{0x10} // a key for the voluntary burns of the current epoch
+
+	// Keys for store prefixes of the rolling values used by the policy updates
+	PolicyIndicatorsKey = []byte{0x11} // prefix for each key to a policy indicators
 )
 
 // GetTaxCapKey - stored by *denom*
@@ -55,6 +104,31 @@ func GetTaxCapKey(denom string) []byte {
 	return append(TaxCapKey, []byte(denom)...)
 }
 
+// GetTaxCapPinKey - stored by *denom*
+func GetTaxCapPinKey(denom string) []byte {
+	return append(TaxCapPinKey, []byte(denom)...)
+}
+
+// GetTaxRateOverrideKey - stored by *denom*
+func GetTaxRateOverrideKey(denom string) []byte {
+	return append(TaxRateOverrideKey, []byte(denom)...)
+}
+
+// GetTaxExemptionKey - stored by *address* or by the ordered *address pair*,
+// so an exemption between two addresses has a single key regardless of direction
+func GetTaxExemptionKey(address, counterparty sdk.AccAddress) []byte {
+	if counterparty.Empty() {
+		return append(TaxExemptionKey, address.Bytes()...)
+	}
+
+	if bytes.Compare(address, counterparty) > 0 {
+		address, counterparty = counterparty, address
+	}
+
+	key := append(TaxExemptionKey, address.Bytes()...)
+	return append(key, counterparty.Bytes()...)
+}
+
 // GetTRKey - stored by *epoch*
 func GetTRKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TRKey, epoch)
@@ -70,9 +144,47 @@ func GetTSLKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TSLKey, epoch)
 }
 
+// GetPolicyIndicatorsKey - stored by *epoch*
+func GetPolicyIndicatorsKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(PolicyIndicatorsKey, epoch)
+}
+
+// GetEpochBoundaryKey - stored by *epoch* in big endian, so boundaries iterate in epoch order
+func GetEpochBoundaryKey(epoch int64) []byte {
+	return append(EpochBoundaryKey, sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetTaxPaymentEpochKey - stored by *epoch* in big endian, so payments iterate in epoch order
+func GetTaxPaymentEpochKey(epoch int64) []byte {
+	return append(TaxPaymentKey, sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetTaxPaymentPayerKey - stored by *epoch* and *payer*
+func GetTaxPaymentPayerKey(epoch int64, payer sdk.AccAddress) []byte {
+	return append(GetTaxPaymentEpochKey(epoch), payer.Bytes()...)
+}
+
+// GetTaxPaymentKey - stored by *epoch*, *payer* and *denom*
+func GetTaxPaymentKey(epoch int64, payer sdk.AccAddress, denom string) []byte {
+	return append(GetTaxPaymentPayerKey(epoch, payer), []byte(denom)...)
+}
+
+// ParseTaxPaymentKey returns the epoch, payer and denom of a key built by GetTaxPaymentKey
+func ParseTaxPaymentKey(key []byte) (epoch int64, payer sdk.AccAddress, denom string) {
+	epoch = int64(binary.BigEndian.Uint64(key[1:9]))
+	payer = sdk.AccAddress(key[9 : 9+sdk.AddrLen])
+	denom = string(key[9+sdk.AddrLen:])
+	return
+}
+
 // GetSubkeyByEpoch - stored by *epoch*
 func GetSubkeyByEpoch(prefix []byte, epoch int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(epoch))
 	return append(prefix, b...)
 }
+
+// GetEpochFromSubkey returns the epoch of a key built by GetSubkeyByEpoch
+func GetEpochFromSubkey(key []byte) int64 {
+	return int64(binary.LittleEndian.Uint64(key[1:]))
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgBurn{}
+)
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgBurn contains a voluntary burn request
+type MsgBurn struct {
+	Burner sdk.AccAddress `json:"burner" yaml:"burner"` // Address of the burner
+	Amount sdk.Coins      `json:"amount" yaml:"amount"` // Coins being burned
+}
+
+// NewMsgBurn creates a MsgBurn instance
+func NewMsgBurn(burnerAddress sdk.AccAddress, amount sdk.Coins) MsgBurn {
+	return MsgBurn{
+		Burner: burnerAddress,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgBurn) Type() string { return "burn" }
+
+// GetSignBytes Implements Msg
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Burner}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if len(msg.Burner) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Burner.String())
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins("Invalid burn amount: " + msg.Amount.String())
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (msg MsgBurn) String() string {
+	return fmt.Sprintf(`MsgBurn
+	burner:    %s, 
+	amount:    %s`,
+		msg.Burner, msg.Amount)
+}
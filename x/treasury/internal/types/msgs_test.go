@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	core "github.com/terra-project/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBurn(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		burner     sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{addrs[0], sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())), true},
+		{addrs[0], sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt())), true},
+		{sdk.AccAddress{}, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())), false},
+		{addrs[0], sdk.Coins{}, false},
+		{addrs[0], sdk.Coins{sdk.Coin{Denom: core.MicroLunaDenom, Amount: sdk.ZeroInt()}}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgBurn(tc.burner, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
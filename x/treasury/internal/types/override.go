@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+// TaxRateOverride replaces the global tax-rate for a single denom
+type TaxRateOverride struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	TaxRate sdk.Dec `json:"tax_rate" yaml:"tax_rate"`
+}
+
+// NewTaxRateOverride creates a TaxRateOverride instance
+func NewTaxRateOverride(denom string, taxRate sdk.Dec) TaxRateOverride {
+	return TaxRateOverride{
+		Denom:   denom,
+		TaxRate: taxRate,
+	}
+}
+
+// Validate performs basic validation of the override
+func (o TaxRateOverride) Validate() error {
+	if len(o.Denom) == 0 {
+		return fmt.Errorf("tax-rate override denom cannot be empty")
+	}
+
+	if o.Denom == core.MicroLunaDenom {
+		return fmt.Errorf("%s is not subject to the stability tax", core.MicroLunaDenom)
+	}
+
+	if o.TaxRate.IsNil() || o.TaxRate.IsNegative() || o.TaxRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid tax-rate for %s: %s", o.Denom, o.TaxRate)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (o TaxRateOverride) String() string {
+	return fmt.Sprintf(`TaxRateOverride:
+  Denom:   %s
+  TaxRate: %s`, o.Denom, o.TaxRate)
+}
+
+// TaxRateOverrides is a collection of TaxRateOverride
+type TaxRateOverrides []TaxRateOverride
+
+// Validate performs basic validation of the overrides and checks for duplicates
+func (os TaxRateOverrides) Validate() error {
+	seen := make(map[string]bool)
+	for _, o := range os {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if seen[o.Denom] {
+			return fmt.Errorf("duplicated tax-rate override: %s", o.Denom)
+		}
+
+		seen[o.Denom] = true
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (os TaxRateOverrides) String() (out string) {
+	for _, o := range os {
+		out += o.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// TaxCapPin pins the tax-cap of a denom, replacing the cap converted from the tax policy.
+// The pin stays in effect up to and including ExpiryEpoch; zero ExpiryEpoch never expires.
+type TaxCapPin struct {
+	Denom       string  `json:"denom" yaml:"denom"`
+	TaxCap      sdk.Int `json:"tax_cap" yaml:"tax_cap"`
+	ExpiryEpoch int64   `json:"expiry_epoch" yaml:"expiry_epoch"`
+}
+
+// NewTaxCapPin creates a TaxCapPin instance
+func NewTaxCapPin(denom string, taxCap sdk.Int, expiryEpoch int64) TaxCapPin {
+	return TaxCapPin{
+		Denom:       denom,
+		TaxCap:      taxCap,
+		ExpiryEpoch: expiryEpoch,
+	}
+}
+
+// IsActive returns true if the pin is in effect at the given epoch
+func (p TaxCapPin) IsActive(epoch int64) bool {
+	return p.ExpiryEpoch == 0 || epoch <= p.ExpiryEpoch
+}
+
+// Validate performs basic validation of the pin
+func (p TaxCapPin) Validate() error {
+	if len(p.Denom) == 0 {
+		return fmt.Errorf("tax-cap pin denom cannot be empty")
+	}
+
+	if p.Denom == core.MicroLunaDenom {
+		return fmt.Errorf("%s is not subject to the stability tax", core.MicroLunaDenom)
+	}
+
+	if p.TaxCap.BigInt() == nil || p.TaxCap.IsNegative() {
+		return fmt.Errorf("invalid tax-cap for %s: %s", p.Denom, p.TaxCap)
+	}
+
+	if p.ExpiryEpoch < 0 {
+		return fmt.Errorf("invalid expiry epoch for %s: %d", p.Denom, p.ExpiryEpoch)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (p TaxCapPin) String() string {
+	return fmt.Sprintf(`TaxCapPin:
+  Denom:       %s
+  TaxCap:      %s
+  ExpiryEpoch: %d`, p.Denom, p.TaxCap, p.ExpiryEpoch)
+}
+
+// TaxCapPins is a collection of TaxCapPin
+type TaxCapPins []TaxCapPin
+
+// Validate performs basic validation of the pins and checks for duplicates
+func (ps TaxCapPins) Validate() error {
+	seen := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if seen[p.Denom] {
+			return fmt.Errorf("duplicated tax-cap pin: %s", p.Denom)
+		}
+
+		seen[p.Denom] = true
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (ps TaxCapPins) String() (out string) {
+	for _, p := range ps {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
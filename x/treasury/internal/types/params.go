@@ -6,7 +6,9 @@ import (
 	core "github.com/terra-project/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // DefaultParamspace defines default space for treasury params
@@ -21,6 +23,10 @@ var (
 	ParamStoreKeyWindowShort             = []byte("windowshort")
 	ParamStoreKeyWindowLong              = []byte("windowlong")
 	ParamStoreKeyWindowProbation         = []byte("windowprobation")
+	ParamStoreKeyEpochLength             = []byte("epochlength")
+	ParamStoreKeySeigniorageRoutes       = []byte("seigniorageroutes")
+	ParamStoreKeyIndicatorRetention      = []byte("indicatorretention")
+	ParamStoreKeyTaxPaymentIndex         = []byte("taxpaymentindex")
 )
 
 // Default parameter values
@@ -42,10 +48,22 @@ var (
 	DefaultWindowShort             = int64(4)                   // a month
 	DefaultWindowLong              = int64(52)                  // a year
 	DefaultWindowProbation         = int64(12)                  // 3 month
+	DefaultEpochLength             = core.BlocksPerEpoch        // a week
+	DefaultIndicatorRetention      = DefaultWindowLong          // a year
+	DefaultTaxPaymentIndex         = false                      // no per-address tax bookkeeping
 	DefaultTaxRate                 = sdk.NewDecWithPrec(1, 3)   // 0.1%
 	DefaultRewardWeight            = sdk.NewDecWithPrec(5, 2)   // 5%
+
+	// all to the community pool
+	DefaultSeigniorageRoutes = SeigniorageRoutes{
+		NewSeigniorageRoute(supply.NewModuleAddress(distr.ModuleName), sdk.OneDec()),
+	}
 )
 
+// MinEpochLength is the shortest epoch allowed; an epoch must also span whole oracle vote
+// periods, so the market swaps aligning the indicators always see fresh exchange rates
+const MinEpochLength = core.BlocksPerMinute
+
 var _ subspace.ParamSet = &Params{}
 
 // Params treasury parameters
@@ -57,6 +75,10 @@ type Params struct {
 	WindowShort             int64             `json:"window_short" yaml:"window_short"`
 	WindowLong              int64             `json:"window_long" yaml:"window_long"`
 	WindowProbation         int64             `json:"window_probation" yaml:"window_probation"`
+	EpochLength             int64             `json:"epoch_length" yaml:"epoch_length"`
+	SeigniorageRoutes       SeigniorageRoutes `json:"seigniorage_routes" yaml:"seigniorage_routes"`
+	IndicatorRetention      int64             `json:"indicator_retention" yaml:"indicator_retention"`
+	TaxPaymentIndex         bool              `json:"tax_payment_index" yaml:"tax_payment_index"`
 }
 
 // DefaultParams creates default treasury module parameters
@@ -69,6 +91,10 @@ func DefaultParams() Params {
 		WindowShort:             DefaultWindowShort,
 		WindowLong:              DefaultWindowLong,
 		WindowProbation:         DefaultWindowProbation,
+		EpochLength:             DefaultEpochLength,
+		SeigniorageRoutes:       DefaultSeigniorageRoutes,
+		IndicatorRetention:      DefaultIndicatorRetention,
+		TaxPaymentIndex:         DefaultTaxPaymentIndex,
 	}
 }
 
@@ -92,7 +118,16 @@ func (params Params) Validate() error {
 		return fmt.Errorf("treasury parameter RewardPolicy.RateMin must be >= 0, is %s", params.RewardPolicy.RateMin)
 	}
 
-	return nil
+	if params.EpochLength < MinEpochLength {
+		return fmt.Errorf("treasury parameter EpochLength must be >= %d, is %d", MinEpochLength, params.EpochLength)
+	}
+
+	if params.IndicatorRetention < params.WindowLong {
+		return fmt.Errorf("treasury parameter IndicatorRetention must be >= WindowLong %d, is %d",
+			params.WindowLong, params.IndicatorRetention)
+	}
+
+	return params.SeigniorageRoutes.Validate()
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -107,6 +142,10 @@ func (params *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: ParamStoreKeyWindowShort, Value: &params.WindowShort},
 		{Key: ParamStoreKeyWindowLong, Value: &params.WindowLong},
 		{Key: ParamStoreKeyWindowProbation, Value: &params.WindowProbation},
+		{Key: ParamStoreKeyEpochLength, Value: &params.EpochLength},
+		{Key: ParamStoreKeySeigniorageRoutes, Value: &params.SeigniorageRoutes},
+		{Key: ParamStoreKeyIndicatorRetention, Value: &params.IndicatorRetention},
+		{Key: ParamStoreKeyTaxPaymentIndex, Value: &params.TaxPaymentIndex},
 	}
 }
 
@@ -121,6 +160,14 @@ func (params Params) String() string {
 
   WindowShort        : %d
   WindowLong         : %d
+  WindowProbation    : %d
+  EpochLength        : %d
+  IndicatorRetention : %d
+  TaxPaymentIndex    : %t
+
+  SeigniorageRoutes  : %s
   `, params.TaxPolicy, params.RewardPolicy, params.SeigniorageBurdenTarget,
-		params.MiningIncrement, params.WindowShort, params.WindowLong)
+		params.MiningIncrement, params.WindowShort, params.WindowLong,
+		params.WindowProbation, params.EpochLength, params.IndicatorRetention,
+		params.TaxPaymentIndex, params.SeigniorageRoutes)
 }
@@ -28,6 +28,40 @@ func TestParams(t *testing.T) {
 	params.RewardPolicy.RateMin = sdk.NewDec(-1)
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.EpochLength = MinEpochLength - 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.IndicatorRetention = params.WindowLong - 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.SeigniorageRoutes = SeigniorageRoutes{}
+	require.Error(t, params.Validate())
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	params.SeigniorageRoutes = SeigniorageRoutes{NewSeigniorageRoute(addr, sdk.NewDecWithPrec(5, 1))}
+	require.Error(t, params.Validate())
+
+	params.SeigniorageRoutes = SeigniorageRoutes{
+		NewSeigniorageRoute(addr, sdk.NewDecWithPrec(5, 1)),
+		NewSeigniorageRoute(addr, sdk.NewDecWithPrec(5, 1)),
+	}
+	require.Error(t, params.Validate())
+
+	params.SeigniorageRoutes = SeigniorageRoutes{
+		NewSeigniorageRoute(addr, sdk.NewDecWithPrec(15, 1)),
+		NewSeigniorageRoute(DefaultSeigniorageRoutes[0].Address, sdk.NewDecWithPrec(-5, 1)),
+	}
+	require.Error(t, params.Validate())
+
+	params.SeigniorageRoutes = SeigniorageRoutes{
+		NewSeigniorageRoute(addr, sdk.NewDecWithPrec(5, 1)),
+		NewSeigniorageRoute(DefaultSeigniorageRoutes[0].Address, sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, params.Validate())
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TaxPayment is the stability tax paid by an address during an epoch
+type TaxPayment struct {
+	Epoch  int64          `json:"epoch" yaml:"epoch"`
+	Payer  sdk.AccAddress `json:"payer" yaml:"payer"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewTaxPayment creates a TaxPayment instance
+func NewTaxPayment(epoch int64, payer sdk.AccAddress, amount sdk.Coins) TaxPayment {
+	return TaxPayment{
+		Epoch:  epoch,
+		Payer:  payer,
+		Amount: amount,
+	}
+}
+
+// Validate performs basic validation of the tax payment
+func (p TaxPayment) Validate() error {
+	if p.Epoch < 0 {
+		return fmt.Errorf("tax payment epoch must be >= 0, is %d", p.Epoch)
+	}
+
+	if len(p.Payer) != sdk.AddrLen {
+		return fmt.Errorf("invalid tax payment payer: %s", p.Payer)
+	}
+
+	if !p.Amount.IsValid() || p.Amount.Empty() {
+		return fmt.Errorf("invalid tax payment amount: %s", p.Amount)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (p TaxPayment) String() string {
+	return fmt.Sprintf(`TaxPayment:
+  Epoch:  %d
+  Payer:  %s
+  Amount: %s`, p.Epoch, p.Payer, p.Amount)
+}
+
+// TaxPayments is a collection of TaxPayment
+type TaxPayments []TaxPayment
+
+// Validate performs basic validation of the tax payments and checks for duplicates
+func (ps TaxPayments) Validate() error {
+	seen := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetTaxPaymentPayerKey(p.Epoch, p.Payer))
+		if seen[key] {
+			return fmt.Errorf("duplicated tax payment of %s at epoch %d", p.Payer, p.Epoch)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (ps TaxPayments) String() (out string) {
+	for _, p := range ps {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PolicyProjection is the outcome of the policy updates
+// which would run at the end of the current epoch
+type PolicyProjection struct {
+	TaxRate      sdk.Dec         `json:"tax_rate" yaml:"tax_rate"`
+	RewardWeight sdk.Dec         `json:"reward_weight" yaml:"reward_weight"`
+	TaxCaps      sdk.Coins       `json:"tax_caps" yaml:"tax_caps"`
+	Indicators   EpochIndicators `json:"indicators" yaml:"indicators"`
+}
+
+// String implements fmt.Stringer interface
+func (pp PolicyProjection) String() string {
+	return fmt.Sprintf(`PolicyProjection:
+  TaxRate:      %s
+  RewardWeight: %s
+  TaxCaps:      %s
+  %s`, pp.TaxRate, pp.RewardWeight, pp.TaxCaps, pp.Indicators)
+}
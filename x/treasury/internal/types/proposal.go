@@ -14,6 +14,18 @@ const (
 
 	// ProposalTypeRewardWeightUpdate defines the type for a RewardWeightUpdateProposal
 	ProposalTypeRewardWeightUpdate = "RewardWeightUpdate"
+
+	// ProposalTypeTaxExemptionAdd defines the type for a TaxExemptionAddProposal
+	ProposalTypeTaxExemptionAdd = "TaxExemptionAdd"
+
+	// ProposalTypeTaxExemptionRemove defines the type for a TaxExemptionRemoveProposal
+	ProposalTypeTaxExemptionRemove = "TaxExemptionRemove"
+
+	// ProposalTypeTaxRateOverrideUpdate defines the type for a TaxRateOverrideUpdateProposal
+	ProposalTypeTaxRateOverrideUpdate = "TaxRateOverrideUpdate"
+
+	// ProposalTypeTaxCapUpdate defines the type for a TaxCapUpdateProposal
+	ProposalTypeTaxCapUpdate = "TaxCapUpdate"
 )
 
 // Assert TaxRateUpdateProposal implements govtypes.Content at compile-time
@@ -22,6 +34,10 @@ var _ gov.Content = TaxRateUpdateProposal{}
 func init() {
 	gov.RegisterProposalType(ProposalTypeTaxRateUpdate)
 	gov.RegisterProposalType(ProposalTypeRewardWeightUpdate)
+	gov.RegisterProposalType(ProposalTypeTaxExemptionAdd)
+	gov.RegisterProposalType(ProposalTypeTaxExemptionRemove)
+	gov.RegisterProposalType(ProposalTypeTaxRateOverrideUpdate)
+	gov.RegisterProposalType(ProposalTypeTaxCapUpdate)
 }
 
 // TaxRateUpdateProposal updates treasury tax-rate
@@ -121,3 +137,219 @@ func (p RewardWeightUpdateProposal) String() string {
 `, p.Title, p.Description, p.RewardWeight))
 	return b.String()
 }
+
+// TaxExemptionAddProposal registers stability tax exemptions
+type TaxExemptionAddProposal struct {
+	Title       string        `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string        `json:"description" yaml:"description"` // Description of the Proposal
+	Exemptions  TaxExemptions `json:"exemptions" yaml:"exemptions"`   // exemptions to register
+}
+
+// NewTaxExemptionAddProposal creates an TaxExemptionAddProposal.
+func NewTaxExemptionAddProposal(title, description string, exemptions TaxExemptions) TaxExemptionAddProposal {
+	return TaxExemptionAddProposal{title, description, exemptions}
+}
+
+// GetTitle returns the title of an TaxExemptionAddProposal.
+func (p TaxExemptionAddProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an TaxExemptionAddProposal.
+func (p TaxExemptionAddProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an TaxExemptionAddProposal.
+func (TaxExemptionAddProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an TaxExemptionAddProposal.
+func (p TaxExemptionAddProposal) ProposalType() string { return ProposalTypeTaxExemptionAdd }
+
+// ValidateBasic runs basic stateless validity checks
+func (p TaxExemptionAddProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Exemptions) == 0 {
+		return ErrInvalidTaxExemption(DefaultCodespace, "no exemptions given")
+	}
+
+	if err := p.Exemptions.Validate(); err != nil {
+		return ErrInvalidTaxExemption(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TaxExemptionAddProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Tax Exemption Add Proposal:
+  Title:        %s
+  Description:  %s
+  Exemptions:   %s
+`, p.Title, p.Description, p.Exemptions))
+	return b.String()
+}
+
+// TaxExemptionRemoveProposal removes stability tax exemptions
+type TaxExemptionRemoveProposal struct {
+	Title       string        `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string        `json:"description" yaml:"description"` // Description of the Proposal
+	Exemptions  TaxExemptions `json:"exemptions" yaml:"exemptions"`   // exemptions to remove; denoms are ignored
+}
+
+// NewTaxExemptionRemoveProposal creates an TaxExemptionRemoveProposal.
+func NewTaxExemptionRemoveProposal(title, description string, exemptions TaxExemptions) TaxExemptionRemoveProposal {
+	return TaxExemptionRemoveProposal{title, description, exemptions}
+}
+
+// GetTitle returns the title of an TaxExemptionRemoveProposal.
+func (p TaxExemptionRemoveProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an TaxExemptionRemoveProposal.
+func (p TaxExemptionRemoveProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an TaxExemptionRemoveProposal.
+func (TaxExemptionRemoveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an TaxExemptionRemoveProposal.
+func (p TaxExemptionRemoveProposal) ProposalType() string { return ProposalTypeTaxExemptionRemove }
+
+// ValidateBasic runs basic stateless validity checks
+func (p TaxExemptionRemoveProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Exemptions) == 0 {
+		return ErrInvalidTaxExemption(DefaultCodespace, "no exemptions given")
+	}
+
+	if err := p.Exemptions.Validate(); err != nil {
+		return ErrInvalidTaxExemption(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TaxExemptionRemoveProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Tax Exemption Remove Proposal:
+  Title:        %s
+  Description:  %s
+  Exemptions:   %s
+`, p.Title, p.Description, p.Exemptions))
+	return b.String()
+}
+
+// TaxRateOverrideUpdateProposal updates the tax-rate override of a denom
+type TaxRateOverrideUpdateProposal struct {
+	Title       string  `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string  `json:"description" yaml:"description"` // Description of the Proposal
+	Denom       string  `json:"denom" yaml:"denom"`             // Denom to override the tax-rate
+	TaxRate     sdk.Dec `json:"tax_rate" yaml:"tax_rate"`       // target TaxRate of the denom
+}
+
+// NewTaxRateOverrideUpdateProposal creates an TaxRateOverrideUpdateProposal.
+func NewTaxRateOverrideUpdateProposal(title, description, denom string, taxRate sdk.Dec) TaxRateOverrideUpdateProposal {
+	return TaxRateOverrideUpdateProposal{title, description, denom, taxRate}
+}
+
+// GetTitle returns the title of an TaxRateOverrideUpdateProposal.
+func (p TaxRateOverrideUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an TaxRateOverrideUpdateProposal.
+func (p TaxRateOverrideUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an TaxRateOverrideUpdateProposal.
+func (TaxRateOverrideUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an TaxRateOverrideUpdateProposal.
+func (p TaxRateOverrideUpdateProposal) ProposalType() string {
+	return ProposalTypeTaxRateOverrideUpdate
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p TaxRateOverrideUpdateProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if err := NewTaxRateOverride(p.Denom, p.TaxRate).Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
+	}
+
+	if !p.TaxRate.IsPositive() {
+		return sdk.ErrInvalidCoins("Invalid tax-rate: " + p.TaxRate.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TaxRateOverrideUpdateProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Tax Rate Override Update Proposal:
+  Title:        %s
+  Description:  %s
+  Denom:        %s
+  TaxRate:      %s
+`, p.Title, p.Description, p.Denom, p.TaxRate))
+	return b.String()
+}
+
+// TaxCapUpdateProposal pins the tax-cap of a denom
+type TaxCapUpdateProposal struct {
+	Title       string  `json:"title" yaml:"title"`               // Title of the Proposal
+	Description string  `json:"description" yaml:"description"`   // Description of the Proposal
+	Denom       string  `json:"denom" yaml:"denom"`               // Denom to pin the tax-cap
+	TaxCap      sdk.Int `json:"tax_cap" yaml:"tax_cap"`           // target TaxCap of the denom
+	ExpiryEpoch int64   `json:"expiry_epoch" yaml:"expiry_epoch"` // last epoch of the pin; zero never expires
+}
+
+// NewTaxCapUpdateProposal creates an TaxCapUpdateProposal.
+func NewTaxCapUpdateProposal(title, description, denom string, taxCap sdk.Int, expiryEpoch int64) TaxCapUpdateProposal {
+	return TaxCapUpdateProposal{title, description, denom, taxCap, expiryEpoch}
+}
+
+// GetTitle returns the title of an TaxCapUpdateProposal.
+func (p TaxCapUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an TaxCapUpdateProposal.
+func (p TaxCapUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an TaxCapUpdateProposal.
+func (TaxCapUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an TaxCapUpdateProposal.
+func (p TaxCapUpdateProposal) ProposalType() string { return ProposalTypeTaxCapUpdate }
+
+// ValidateBasic runs basic stateless validity checks
+func (p TaxCapUpdateProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if err := NewTaxCapPin(p.Denom, p.TaxCap, p.ExpiryEpoch).Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TaxCapUpdateProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Tax Cap Update Proposal:
+  Title:        %s
+  Description:  %s
+  Denom:        %s
+  TaxCap:       %s
+  ExpiryEpoch:  %d
+`, p.Title, p.Description, p.Denom, p.TaxCap, p.ExpiryEpoch))
+	return b.String()
+}
@@ -47,3 +47,110 @@ func TestRewardWeightUpdateProposal(t *testing.T) {
 	proposal = NewRewardWeightUpdateProposal("title", "description", sdk.NewDecWithPrec(1, 1))
 	require.NoError(t, proposal.ValidateBasic())
 }
+
+func TestTaxExemptionAddProposal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	exemptions := TaxExemptions{NewTaxExemption(addr, nil, nil)}
+
+	// invalid title
+	proposal := NewTaxExemptionAddProposal("", "description", exemptions)
+	require.Error(t, proposal.ValidateBasic())
+
+	// empty exemptions
+	proposal = NewTaxExemptionAddProposal("title", "description", TaxExemptions{})
+	require.Error(t, proposal.ValidateBasic())
+
+	// duplicated exemptions
+	proposal = NewTaxExemptionAddProposal("title", "description", append(exemptions, exemptions[0]))
+	require.Error(t, proposal.ValidateBasic())
+
+	// counterparty equal to the address
+	proposal = NewTaxExemptionAddProposal("title", "description", TaxExemptions{NewTaxExemption(addr, addr, nil)})
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxExemptionAddProposal("title", "description", exemptions)
+	require.NoError(t, proposal.ValidateBasic())
+}
+
+func TestTaxExemptionRemoveProposal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	exemptions := TaxExemptions{NewTaxExemption(addr, nil, nil)}
+
+	// invalid descrription
+	proposal := NewTaxExemptionRemoveProposal("title", "", exemptions)
+	require.Error(t, proposal.ValidateBasic())
+
+	// empty exemptions
+	proposal = NewTaxExemptionRemoveProposal("title", "description", nil)
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxExemptionRemoveProposal("title", "description", exemptions)
+	require.NoError(t, proposal.ValidateBasic())
+}
+
+func TestTaxExemptionsIsExempt(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	exemptions := TaxExemptions{
+		NewTaxExemption(addr1, nil, []string{"ukrw"}),
+		NewTaxExemption(addr2, addr3, nil),
+	}
+
+	require.True(t, exemptions.IsExempt(addr1, nil, "ukrw"))
+	require.True(t, exemptions.IsExempt(addr1, addr2, "ukrw"))
+	require.False(t, exemptions.IsExempt(addr1, addr2, "usdr"))
+
+	require.False(t, exemptions.IsExempt(addr2, nil, "usdr"))
+	require.True(t, exemptions.IsExempt(addr2, addr3, "usdr"))
+	require.True(t, exemptions.IsExempt(addr3, addr2, "usdr"))
+	require.False(t, exemptions.IsExempt(addr3, addr1, "usdr"))
+}
+
+func TestTaxRateOverrideUpdateProposal(t *testing.T) {
+	// invalid title
+	proposal := NewTaxRateOverrideUpdateProposal("", "description", "ukrw", sdk.NewDecWithPrec(1, 3))
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid denom
+	proposal = NewTaxRateOverrideUpdateProposal("title", "description", "", sdk.NewDecWithPrec(1, 3))
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxRateOverrideUpdateProposal("title", "description", "uluna", sdk.NewDecWithPrec(1, 3))
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid tax-rate
+	proposal = NewTaxRateOverrideUpdateProposal("title", "description", "ukrw", sdk.NewDec(2))
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxRateOverrideUpdateProposal("title", "description", "ukrw", sdk.ZeroDec())
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxRateOverrideUpdateProposal("title", "description", "ukrw", sdk.NewDecWithPrec(1, 3))
+	require.NoError(t, proposal.ValidateBasic())
+}
+
+func TestTaxCapUpdateProposal(t *testing.T) {
+	// invalid title
+	proposal := NewTaxCapUpdateProposal("", "description", "ukrw", sdk.NewInt(1000), 0)
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid denom
+	proposal = NewTaxCapUpdateProposal("title", "description", "", sdk.NewInt(1000), 0)
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxCapUpdateProposal("title", "description", "uluna", sdk.NewInt(1000), 0)
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid tax-cap
+	proposal = NewTaxCapUpdateProposal("title", "description", "ukrw", sdk.NewInt(-1), 0)
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid expiry epoch
+	proposal = NewTaxCapUpdateProposal("title", "description", "ukrw", sdk.NewInt(1000), -1)
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal = NewTaxCapUpdateProposal("title", "description", "ukrw", sdk.NewInt(1000), 10)
+	require.NoError(t, proposal.ValidateBasic())
+}
@@ -1,15 +1,43 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryTaxRate             = "taxRate"
-	QueryTaxCap              = "taxCap"
-	QueryRewardWeight        = "rewardWeight"
-	QuerySeigniorageProceeds = "seigniorageProceeds"
-	QueryTaxProceeds         = "taxProceeds"
-	QueryParameters          = "parameters"
+	QueryTaxRate               = "taxRate"
+	QueryTaxCap                = "taxCap"
+	QueryRewardWeight          = "rewardWeight"
+	QuerySeigniorageProceeds   = "seigniorageProceeds"
+	QueryTaxProceeds           = "taxProceeds"
+	QueryParameters            = "parameters"
+	QueryCurrentEpoch          = "currentEpoch"
+	QueryIndicators            = "indicators"
+	QueryProjectPolicy         = "projectPolicy"
+	QueryTaxExemptions         = "taxExemptions"
+	QueryTaxRateOverrides      = "taxRateOverrides"
+	QuerySeigniorageSettlement = "seigniorageSettlement"
+	QueryTaxCapPins            = "taxCapPins"
+	QueryTaxPayments           = "taxPayments"
+	QueryBurns                 = "burns"
 )
 
+// QueryTaxRateParams for query
+// - 'custom/treasury/taxRate
+//
+// An empty Denom queries the global tax-rate.
+type QueryTaxRateParams struct {
+	Denom string
+}
+
+// NewQueryTaxRateParams returns new QueryTaxRateParams instance
+func NewQueryTaxRateParams(denom string) QueryTaxRateParams {
+	return QueryTaxRateParams{
+		Denom: denom,
+	}
+}
+
 // QueryTaxCapParams for query
 // - 'custom/treasury/taxRate
 type QueryTaxCapParams struct {
@@ -22,3 +50,57 @@ func NewQueryTaxCapParams(denom string) QueryTaxCapParams {
 		Denom: denom,
 	}
 }
+
+// QueryIndicatorsParams for query
+// - 'custom/treasury/indicators
+type QueryIndicatorsParams struct {
+	StartEpoch int64
+	EndEpoch   int64
+}
+
+// NewQueryIndicatorsParams returns new QueryIndicatorsParams instance
+func NewQueryIndicatorsParams(startEpoch, endEpoch int64) QueryIndicatorsParams {
+	return QueryIndicatorsParams{
+		StartEpoch: startEpoch,
+		EndEpoch:   endEpoch,
+	}
+}
+
+// QueryTaxPaymentsParams for query
+// - 'custom/treasury/taxPayments
+type QueryTaxPaymentsParams struct {
+	Payer      sdk.AccAddress
+	StartEpoch int64
+	EndEpoch   int64
+}
+
+// NewQueryTaxPaymentsParams returns new QueryTaxPaymentsParams instance
+func NewQueryTaxPaymentsParams(payer sdk.AccAddress, startEpoch, endEpoch int64) QueryTaxPaymentsParams {
+	return QueryTaxPaymentsParams{
+		Payer:      payer,
+		StartEpoch: startEpoch,
+		EndEpoch:   endEpoch,
+	}
+}
+
+// QueryProjectPolicyParams for query
+// - 'custom/treasury/projectPolicy
+//
+// Every field is optional; a nil field keeps the on-chain value.
+// TR, SR and TSL replace the indicators recorded for the current epoch.
+type QueryProjectPolicyParams struct {
+	Params *Params  `json:"params,omitempty" yaml:"params,omitempty"`
+	TR     *sdk.Dec `json:"TR,omitempty" yaml:"TR,omitempty"`
+	SR     *sdk.Dec `json:"SR,omitempty" yaml:"SR,omitempty"`
+	TSL    *sdk.Int `json:"TSL,omitempty" yaml:"TSL,omitempty"`
+}
+
+// NewQueryProjectPolicyParams returns new QueryProjectPolicyParams instance
+func NewQueryProjectPolicyParams(params *Params, TR *sdk.Dec, SR *sdk.Dec, TSL *sdk.Int) QueryProjectPolicyParams {
+	return QueryProjectPolicyParams{
+		Params: params,
+		TR:     TR,
+		SR:     SR,
+		TSL:    TSL,
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SeigniorageRoute defines a recipient of seigniorage, which is a module account,
+// a plain account or a wasm contract, with its weight of the non-oracle share
+type SeigniorageRoute struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Weight  sdk.Dec        `json:"weight" yaml:"weight"`
+}
+
+// NewSeigniorageRoute creates a SeigniorageRoute instance
+func NewSeigniorageRoute(address sdk.AccAddress, weight sdk.Dec) SeigniorageRoute {
+	return SeigniorageRoute{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (r SeigniorageRoute) String() string {
+	return fmt.Sprintf(`SeigniorageRoute:
+  Address: %s
+  Weight:  %s`, r.Address, r.Weight)
+}
+
+// SeigniorageRoutes is a collection of SeigniorageRoute
+type SeigniorageRoutes []SeigniorageRoute
+
+// Validate checks the routes have distinct recipients with positive weights summing to one
+func (rs SeigniorageRoutes) Validate() error {
+	if len(rs) == 0 {
+		return fmt.Errorf("seigniorage routes cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	weightSum := sdk.ZeroDec()
+	for _, r := range rs {
+		if r.Address.Empty() {
+			return fmt.Errorf("seigniorage route address cannot be empty")
+		}
+
+		if r.Weight.IsNil() || !r.Weight.IsPositive() {
+			return fmt.Errorf("seigniorage route weight of %s must be positive, is %s", r.Address, r.Weight)
+		}
+
+		if seen[r.Address.String()] {
+			return fmt.Errorf("duplicated seigniorage route: %s", r.Address)
+		}
+
+		seen[r.Address.String()] = true
+		weightSum = weightSum.Add(r.Weight)
+	}
+
+	if !weightSum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("seigniorage route weights must sum to 1, is %s", weightSum)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (rs SeigniorageRoutes) String() (out string) {
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// SeigniorageTransfer records the seigniorage sent to a recipient
+type SeigniorageTransfer struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewSeigniorageTransfer creates a SeigniorageTransfer instance
+func NewSeigniorageTransfer(recipient sdk.AccAddress, amount sdk.Coins) SeigniorageTransfer {
+	return SeigniorageTransfer{
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// SeigniorageSettlement is the breakdown of the seigniorage settled at the end of an epoch
+type SeigniorageSettlement struct {
+	Epoch     int64                 `json:"epoch" yaml:"epoch"`
+	Amount    sdk.Coins             `json:"amount" yaml:"amount"`
+	Transfers []SeigniorageTransfer `json:"transfers" yaml:"transfers"`
+}
+
+// String implements fmt.Stringer interface
+func (s SeigniorageSettlement) String() (out string) {
+	out = fmt.Sprintf(`SeigniorageSettlement:
+  Epoch:     %d
+  Amount:    %s
+  Transfers:`, s.Epoch, s.Amount)
+	for _, t := range s.Transfers {
+		out += fmt.Sprintf("\n    %s: %s", t.Recipient, t.Amount)
+	}
+	return
+}
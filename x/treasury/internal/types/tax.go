@@ -0,0 +1,115 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	core "github.com/terra-project/core/types"
+)
+
+// TaxPolicyKeeper reads the tax-rate and tax-cap of a denom
+type TaxPolicyKeeper interface {
+	GetDenomTaxRate(ctx sdk.Context, denom string) (rate sdk.Dec)
+	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+}
+
+// TaxExemptionKeeper reads the stability tax exemptions
+type TaxExemptionKeeper interface {
+	IsTaxExempt(ctx sdk.Context, sender, recipient sdk.AccAddress, denom string) bool
+}
+
+// ComputeTax computes the stability tax on the principal according to the tax-rate and tax-cap
+// of each denom; Luna is not taxed. The tax-rate and tax-cap are read through the given
+// functions, which lets the clients compute the same tax from queried values.
+func ComputeTax(principal sdk.Coins, getTaxRate func(denom string) (sdk.Dec, error),
+	getTaxCap func(denom string) (sdk.Int, error)) (taxes sdk.Coins, err error) {
+
+	for _, coin := range principal {
+		if coin.Denom == core.MicroLunaDenom {
+			continue
+		}
+
+		taxRate, err := getTaxRate(coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if taxRate.Equal(sdk.ZeroDec()) {
+			continue
+		}
+
+		taxCap, err := getTaxCap(coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
+
+		// If tax due is greater than the tax cap, cap!
+		if taxDue.GT(taxCap) {
+			taxDue = taxCap
+		}
+
+		if taxDue.Equal(sdk.ZeroInt()) {
+			continue
+		}
+
+		taxes = taxes.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, taxDue)))
+	}
+
+	return taxes, nil
+}
+
+// ComputeTaxFromKeeper computes the stability tax on the principal with the tax-rate and
+// tax-cap in the state
+func ComputeTaxFromKeeper(ctx sdk.Context, tk TaxPolicyKeeper, principal sdk.Coins) sdk.Coins {
+	taxes, _ := ComputeTax(principal,
+		func(denom string) (sdk.Dec, error) { return tk.GetDenomTaxRate(ctx, denom), nil },
+		func(denom string) (sdk.Int, error) { return tk.GetTaxCap(ctx, denom), nil },
+	)
+
+	return taxes
+}
+
+// FilterTaxExemptCoins drops the coins exempt from the stability tax out of the principal sent by sender.
+// A coin is exempt when the sender is exempt by itself, or when the sender is paired with every output
+// receiving the denom. The exemptions are checked with the queried ones, which lets the clients
+// filter the same coins as the ante handler.
+func FilterTaxExemptCoins(exemptions TaxExemptions, sender sdk.AccAddress, outputs []bank.Output, principal sdk.Coins) sdk.Coins {
+	return filterTaxExemptCoins(sender, outputs, principal, exemptions.IsExempt)
+}
+
+// FilterTaxExemptCoinsFromKeeper drops the coins exempt from the stability tax out of the principal
+// sent by sender, with the exemptions in the state
+func FilterTaxExemptCoinsFromKeeper(ctx sdk.Context, tk TaxExemptionKeeper, sender sdk.AccAddress, outputs []bank.Output, principal sdk.Coins) sdk.Coins {
+	return filterTaxExemptCoins(sender, outputs, principal, func(sender, recipient sdk.AccAddress, denom string) bool {
+		return tk.IsTaxExempt(ctx, sender, recipient, denom)
+	})
+}
+
+func filterTaxExemptCoins(sender sdk.AccAddress, outputs []bank.Output, principal sdk.Coins,
+	isExempt func(sender, recipient sdk.AccAddress, denom string) bool) (taxable sdk.Coins) {
+
+	for _, coin := range principal {
+		exempt := isExempt(sender, nil, coin.Denom)
+		if !exempt {
+			exempt = true
+			for _, output := range outputs {
+				if output.Coins.AmountOf(coin.Denom).IsZero() {
+					continue
+				}
+
+				if !isExempt(sender, output.Address, coin.Denom) {
+					exempt = false
+					break
+				}
+			}
+		}
+
+		if !exempt {
+			taxable = append(taxable, coin)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestComputeTax(t *testing.T) {
+	taxRate := func(denom string) (sdk.Dec, error) {
+		if denom == core.MicroKRWDenom {
+			return sdk.ZeroDec(), nil
+		}
+		return sdk.NewDecWithPrec(1, 2), nil // 1%
+	}
+	taxCap := func(denom string) (sdk.Int, error) { return sdk.NewInt(100), nil }
+
+	principal := sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1000),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 1000),
+		sdk.NewInt64Coin(core.MicroSDRDenom, 1000),
+		sdk.NewInt64Coin(core.MicroUSDDenom, 1000000),
+	)
+
+	// luna and zero rates are not taxed, the tax is capped
+	taxes, err := ComputeTax(principal, taxRate, taxCap)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10), sdk.NewInt64Coin(core.MicroUSDDenom, 100)), taxes)
+
+	// dust is not taxed
+	taxes, err = ComputeTax(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 99)), taxRate, taxCap)
+	require.NoError(t, err)
+	require.True(t, taxes.Empty())
+
+	_, err = ComputeTax(principal, taxRate, func(denom string) (sdk.Int, error) {
+		return sdk.Int{}, fmt.Errorf("no tax cap")
+	})
+	require.Error(t, err)
+}
+
+func TestFilterTaxExemptCoins(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	hot := sdk.AccAddress([]byte("hot_________________"))
+	cold := sdk.AccAddress([]byte("cold________________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+
+	exemptions := TaxExemptions{
+		NewTaxExemption(sender, hot, nil),
+		NewTaxExemption(sender, cold, []string{core.MicroKRWDenom}),
+	}
+
+	principal := sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1000), sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+
+	// paired with the single output
+	outputs := []bank.Output{bank.NewOutput(hot, principal)}
+	require.True(t, FilterTaxExemptCoins(exemptions, sender, outputs, principal).Empty())
+
+	// the exemption of the pair is scoped to krw
+	outputs = []bank.Output{bank.NewOutput(cold, principal)}
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(core.MicroSDRDenom, 1000)}, FilterTaxExemptCoins(exemptions, sender, outputs, principal))
+
+	// every output receiving the denom must be paired with the sender
+	outputs = []bank.Output{
+		bank.NewOutput(hot, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 500))),
+		bank.NewOutput(other, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 500), sdk.NewInt64Coin(core.MicroSDRDenom, 1000))),
+	}
+	require.Equal(t, principal, FilterTaxExemptCoins(exemptions, sender, outputs, principal))
+
+	// a sender exempt by itself
+	exemptions = append(exemptions, NewTaxExemption(other, nil, nil))
+	require.True(t, FilterTaxExemptCoins(exemptions, other, outputs, principal).Empty())
+}
@@ -0,0 +1,45 @@
+// nolint
+package v03
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "treasury"
+)
+
+type (
+	// PolicyConstraints wraps constraints around updating a key Treasury variable
+	PolicyConstraints struct {
+		RateMin       sdk.Dec  `json:"rate_min"`
+		RateMax       sdk.Dec  `json:"rate_max"`
+		Cap           sdk.Coin `json:"cap"`
+		ChangeRateMax sdk.Dec  `json:"change_max"`
+	}
+
+	// Params treasury parameters
+	Params struct {
+		TaxPolicy               PolicyConstraints `json:"tax_policy" yaml:"tax_policy"`
+		RewardPolicy            PolicyConstraints `json:"reward_policy" yaml:"reward_policy"`
+		SeigniorageBurdenTarget sdk.Dec           `json:"seigniorage_burden_target" yaml:"seigniorage_burden_target"`
+		MiningIncrement         sdk.Dec           `json:"mining_increment" yaml:"mining_increment"`
+		WindowShort             int64             `json:"window_short" yaml:"window_short"`
+		WindowLong              int64             `json:"window_long" yaml:"window_long"`
+		WindowProbation         int64             `json:"window_probation" yaml:"window_probation"`
+	}
+
+	// GenesisState - all treasury state that must be provided at genesis;
+	// indicators are positional, the slice index being the epoch
+	GenesisState struct {
+		Params               Params             `json:"params" yaml:"params"`
+		TaxRate              sdk.Dec            `json:"tax_rate" yaml:"tax_rate"`
+		RewardWeight         sdk.Dec            `json:"reward_weight" yaml:"reward_weight"`
+		TaxCaps              map[string]sdk.Int `json:"tax_caps" yaml:"tax_caps"`
+		TaxProceed           sdk.Coins          `json:"tax_proceed" yaml:"tax_proceed"`
+		EpochInitialIssuance sdk.Coins          `json:"epoch_initial_issuance" yaml:"epoch_initial_issuance"`
+		TRs                  []sdk.Dec          `json:"TRs" yaml:"TRs"`
+		SRs                  []sdk.Dec          `json:"SRs" yaml:"SRs"`
+		TSLs                 []sdk.Int          `json:"TSLs" yaml:"TSLs"`
+	}
+)
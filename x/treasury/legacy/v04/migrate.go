@@ -0,0 +1,82 @@
+package v04
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury"
+	v03treasury "github.com/terra-project/core/x/treasury/legacy/v03"
+)
+
+// Migrate accepts exported genesis state from v0.3 and migrates it to v0.4
+// genesis state. Positional indicators are converted to explicit epochs and
+// only the most recent IndicatorRetention epochs are carried over. Params
+// introduced since v0.3 are filled with their defaults.
+func Migrate(oldGenState v03treasury.GenesisState) treasury.GenesisState {
+	params := treasury.Params{
+		TaxPolicy:               migratePolicyConstraints(oldGenState.Params.TaxPolicy),
+		RewardPolicy:            migratePolicyConstraints(oldGenState.Params.RewardPolicy),
+		SeigniorageBurdenTarget: oldGenState.Params.SeigniorageBurdenTarget,
+		MiningIncrement:         oldGenState.Params.MiningIncrement,
+		WindowShort:             oldGenState.Params.WindowShort,
+		WindowLong:              oldGenState.Params.WindowLong,
+		WindowProbation:         oldGenState.Params.WindowProbation,
+		EpochLength:             treasury.DefaultEpochLength,
+		SeigniorageRoutes:       treasury.DefaultSeigniorageRoutes,
+		IndicatorRetention:      treasury.DefaultIndicatorRetention,
+		TaxPaymentIndex:         treasury.DefaultTaxPaymentIndex,
+	}
+
+	// retention can never be shorter than the long window
+	if params.IndicatorRetention < params.WindowLong {
+		params.IndicatorRetention = params.WindowLong
+	}
+
+	TRs := []treasury.EpochDec{}
+	for epoch, TR := range oldGenState.TRs {
+		if isRetained(epoch, len(oldGenState.TRs), params.IndicatorRetention) {
+			TRs = append(TRs, treasury.NewEpochDec(int64(epoch), TR))
+		}
+	}
+
+	SRs := []treasury.EpochDec{}
+	for epoch, SR := range oldGenState.SRs {
+		if isRetained(epoch, len(oldGenState.SRs), params.IndicatorRetention) {
+			SRs = append(SRs, treasury.NewEpochDec(int64(epoch), SR))
+		}
+	}
+
+	TSLs := []treasury.EpochInt{}
+	for epoch, TSL := range oldGenState.TSLs {
+		if isRetained(epoch, len(oldGenState.TSLs), params.IndicatorRetention) {
+			TSLs = append(TSLs, treasury.NewEpochInt(int64(epoch), TSL))
+		}
+	}
+
+	taxCaps := make(map[string]sdk.Int)
+	for denom, taxCap := range oldGenState.TaxCaps {
+		taxCaps[denom] = taxCap
+	}
+
+	return treasury.NewGenesisState(
+		params, oldGenState.TaxRate, oldGenState.RewardWeight,
+		taxCaps, oldGenState.TaxProceed, oldGenState.EpochInitialIssuance,
+		TRs, SRs, TSLs, []treasury.EpochPolicyIndicators{}, treasury.TaxExemptions{}, treasury.TaxRateOverrides{},
+		treasury.EpochBoundaries{}, treasury.TaxCapPins{}, treasury.TaxPayments{},
+		sdk.Coins{}, sdk.Coins{},
+	)
+}
+
+func migratePolicyConstraints(pc v03treasury.PolicyConstraints) treasury.PolicyConstraints {
+	return treasury.PolicyConstraints{
+		RateMin:       pc.RateMin,
+		RateMax:       pc.RateMax,
+		Cap:           pc.Cap,
+		ChangeRateMax: pc.ChangeRateMax,
+	}
+}
+
+// isRetained returns whether the positional indicator at the given epoch falls
+// within the retention window of the last recorded epoch
+func isRetained(epoch int, count int, retention int64) bool {
+	return int64(count-epoch) <= retention
+}
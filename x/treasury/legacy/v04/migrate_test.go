@@ -0,0 +1,71 @@
+package v04
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/treasury"
+	v03treasury "github.com/terra-project/core/x/treasury/legacy/v03"
+)
+
+func TestMigrate(t *testing.T) {
+	defaultParams := treasury.DefaultParams()
+	taxPolicy := v03treasury.PolicyConstraints{
+		RateMin:       defaultParams.TaxPolicy.RateMin,
+		RateMax:       defaultParams.TaxPolicy.RateMax,
+		Cap:           defaultParams.TaxPolicy.Cap,
+		ChangeRateMax: defaultParams.TaxPolicy.ChangeRateMax,
+	}
+	rewardPolicy := v03treasury.PolicyConstraints{
+		RateMin:       defaultParams.RewardPolicy.RateMin,
+		RateMax:       defaultParams.RewardPolicy.RateMax,
+		Cap:           defaultParams.RewardPolicy.Cap,
+		ChangeRateMax: defaultParams.RewardPolicy.ChangeRateMax,
+	}
+
+	oldGenState := v03treasury.GenesisState{
+		Params: v03treasury.Params{
+			TaxPolicy:               taxPolicy,
+			RewardPolicy:            rewardPolicy,
+			SeigniorageBurdenTarget: defaultParams.SeigniorageBurdenTarget,
+			MiningIncrement:         defaultParams.MiningIncrement,
+			WindowShort:             4,
+			WindowLong:              3,
+			WindowProbation:         1,
+		},
+		TaxRate:              sdk.NewDecWithPrec(1, 3),
+		RewardWeight:         sdk.NewDecWithPrec(5, 2),
+		TaxCaps:              map[string]sdk.Int{"ukrw": sdk.NewInt(1000)},
+		TaxProceed:           sdk.Coins{},
+		EpochInitialIssuance: sdk.Coins{},
+	}
+
+	for epoch := int64(0); epoch < treasury.DefaultIndicatorRetention+5; epoch++ {
+		oldGenState.TRs = append(oldGenState.TRs, sdk.NewDec(epoch))
+		oldGenState.SRs = append(oldGenState.SRs, sdk.NewDec(epoch))
+		oldGenState.TSLs = append(oldGenState.TSLs, sdk.NewInt(epoch))
+	}
+
+	genState := Migrate(oldGenState)
+	require.NoError(t, treasury.ValidateGenesis(genState))
+
+	// retention is never shorter than the long window
+	require.Equal(t, treasury.DefaultIndicatorRetention, genState.Params.IndicatorRetention)
+	require.Equal(t, treasury.DefaultEpochLength, genState.Params.EpochLength)
+	require.Equal(t, int64(3), genState.Params.WindowLong)
+	require.Equal(t, oldGenState.TaxCaps, genState.TaxCaps)
+
+	// only the last IndicatorRetention epochs are kept, with their epochs explicit
+	require.Len(t, genState.TRs, int(treasury.DefaultIndicatorRetention))
+	require.Len(t, genState.SRs, int(treasury.DefaultIndicatorRetention))
+	require.Len(t, genState.TSLs, int(treasury.DefaultIndicatorRetention))
+	for i, TR := range genState.TRs {
+		epoch := int64(i + 5)
+		require.Equal(t, treasury.NewEpochDec(epoch, sdk.NewDec(epoch)), TR)
+		require.Equal(t, treasury.NewEpochDec(epoch, sdk.NewDec(epoch)), genState.SRs[i])
+		require.Equal(t, treasury.NewEpochInt(epoch, sdk.NewInt(epoch)), genState.TSLs[i])
+	}
+}
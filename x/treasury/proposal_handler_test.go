@@ -1,11 +1,15 @@
 package treasury
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle"
+	"github.com/terra-project/core/x/params"
 	"github.com/terra-project/core/x/treasury/internal/keeper"
 	"github.com/terra-project/core/x/treasury/internal/types"
 )
@@ -45,3 +49,117 @@ func TestRewardWeightUpdateProposalHandler(t *testing.T) {
 	require.NoError(t, hdlr(input.Ctx, tp))
 	require.Equal(t, rewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
 }
+
+func TestTaxExemptionProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	hdlr := NewTreasuryPolicyUpdateHandler(input.TreasuryKeeper)
+
+	exemptions := types.TaxExemptions{
+		types.NewTaxExemption(keeper.Addrs[0], nil, nil),
+		types.NewTaxExemption(keeper.Addrs[1], keeper.Addrs[2], []string{core.MicroSDRDenom}),
+	}
+
+	require.NoError(t, hdlr(input.Ctx, types.NewTaxExemptionAddProposal("Test", "description", exemptions)))
+	require.Equal(t, 2, len(input.TreasuryKeeper.GetTaxExemptions(input.Ctx)))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, keeper.Addrs[2], keeper.Addrs[1], core.MicroSDRDenom))
+
+	// removing an unregistered exemption fails without removing anything
+	unknown := types.NewTaxExemption(keeper.Addrs[2], nil, nil)
+	require.Error(t, hdlr(input.Ctx, types.NewTaxExemptionRemoveProposal("Test", "description", append(exemptions, unknown))))
+	require.Equal(t, 2, len(input.TreasuryKeeper.GetTaxExemptions(input.Ctx)))
+
+	require.NoError(t, hdlr(input.Ctx, types.NewTaxExemptionRemoveProposal("Test", "description", exemptions[:1])))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, keeper.Addrs[0], nil, core.MicroSDRDenom))
+	require.Equal(t, 1, len(input.TreasuryKeeper.GetTaxExemptions(input.Ctx)))
+}
+
+func TestTaxRateOverrideUpdateProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	hdlr := NewTreasuryPolicyUpdateHandler(input.TreasuryKeeper)
+
+	taxRate := sdk.NewDecWithPrec(1, 3)
+	input.TreasuryKeeper.SetTaxRate(input.Ctx, taxRate)
+
+	krwRate := sdk.NewDecWithPrec(11, 4)
+	tp := types.NewTaxRateOverrideUpdateProposal("Test", "description", core.MicroKRWDenom, krwRate)
+	require.NoError(t, hdlr(input.Ctx, tp))
+	require.Equal(t, krwRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroSDRDenom))
+
+	// the change is clamped by the tax policy
+	taxPolicy := input.TreasuryKeeper.TaxPolicy(input.Ctx)
+	tp = types.NewTaxRateOverrideUpdateProposal("Test", "description", core.MicroKRWDenom, sdk.OneDec())
+	require.NoError(t, hdlr(input.Ctx, tp))
+	require.Equal(t, taxPolicy.Clamp(krwRate, sdk.OneDec()), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+}
+
+func TestTaxCapUpdateProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	hdlr := NewTreasuryPolicyUpdateHandler(input.TreasuryKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch * 2)
+
+	// expiry epoch already passed
+	tp := types.NewTaxCapUpdateProposal("Test", "description", core.MicroKRWDenom, sdk.NewInt(1000), 1)
+	require.Error(t, hdlr(input.Ctx, tp))
+
+	taxCap := sdk.NewInt(1000)
+	tp = types.NewTaxCapUpdateProposal("Test", "description", core.MicroKRWDenom, taxCap, 2)
+	require.NoError(t, hdlr(input.Ctx, tp))
+	require.Equal(t, taxCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	hdlr := NewParamChangeProposalHandler(input.TreasuryKeeper, params.NewParamChangeProposalHandler(input.ParamsKeeper))
+
+	newProposal := func(changes ...params.ParamChange) params.ParameterChangeProposal {
+		return params.NewParameterChangeProposal("Test", "description", changes)
+	}
+	epochLengthChange := func(epochLength int64) params.ParamChange {
+		return params.NewParamChange(types.DefaultParamspace, string(types.ParamStoreKeyEpochLength), fmt.Sprintf("\"%d\"", epochLength))
+	}
+	votePeriodChange := func(votePeriod int64) params.ParamChange {
+		return params.NewParamChange(oracle.DefaultParamspace, string(oracle.ParamStoreKeyVotePeriod), fmt.Sprintf("\"%d\"", votePeriod))
+	}
+
+	// epoch-length not a multiple of the vote period
+	require.Error(t, hdlr(input.Ctx, newProposal(epochLengthChange(core.BlocksPerHour+1))))
+	require.Equal(t, types.DefaultEpochLength, input.TreasuryKeeper.EpochLength(input.Ctx))
+
+	require.NoError(t, hdlr(input.Ctx, newProposal(epochLengthChange(core.BlocksPerHour))))
+	require.Equal(t, core.BlocksPerHour, input.TreasuryKeeper.EpochLength(input.Ctx))
+
+	// vote period longer than the epoch-length
+	require.Error(t, hdlr(input.Ctx, newProposal(votePeriodChange(core.BlocksPerHour*2))))
+	require.Equal(t, oracle.DefaultVotePeriod, input.OracleKeeper.VotePeriod(input.Ctx))
+
+	// both changed together
+	require.NoError(t, hdlr(input.Ctx, newProposal(votePeriodChange(core.BlocksPerHour*2), epochLengthChange(core.BlocksPerDay))))
+	require.Equal(t, core.BlocksPerHour*2, input.OracleKeeper.VotePeriod(input.Ctx))
+	require.Equal(t, core.BlocksPerDay, input.TreasuryKeeper.EpochLength(input.Ctx))
+
+	// other param changes are not checked
+	windowChange := params.NewParamChange(types.DefaultParamspace, string(types.ParamStoreKeyWindowShort), "\"8\"")
+	require.NoError(t, hdlr(input.Ctx, newProposal(windowChange)))
+	require.Equal(t, int64(8), input.TreasuryKeeper.WindowShort(input.Ctx))
+
+	routesChange := func(routes types.SeigniorageRoutes) params.ParamChange {
+		bz := types.ModuleCdc.MustMarshalJSON(routes)
+		return params.NewParamChange(types.DefaultParamspace, string(types.ParamStoreKeySeigniorageRoutes), string(bz))
+	}
+
+	// seigniorage route weights not summing to one
+	invalidRoutes := types.SeigniorageRoutes{types.NewSeigniorageRoute(keeper.Addrs[0], sdk.NewDecWithPrec(5, 1))}
+	err := hdlr(input.Ctx, newProposal(routesChange(invalidRoutes)))
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidSeigniorageRoutes, err.Code())
+	require.Equal(t, types.DefaultSeigniorageRoutes, input.TreasuryKeeper.SeigniorageRoutes(input.Ctx))
+
+	routes := types.SeigniorageRoutes{
+		types.NewSeigniorageRoute(keeper.Addrs[0], sdk.NewDecWithPrec(5, 1)),
+		types.NewSeigniorageRoute(keeper.Addrs[1], sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, hdlr(input.Ctx, newProposal(routesChange(routes))))
+	require.Equal(t, routes, input.TreasuryKeeper.SeigniorageRoutes(input.Ctx))
+}
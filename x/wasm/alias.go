@@ -11,81 +11,172 @@ import (
 )
 
 const (
-	DefaultCodespace      = types.DefaultCodespace
-	CodeCreatedFailed     = types.CodeCreatedFailed
-	CodeAccountExists     = types.CodeAccountExists
-	CodeInstantiateFailed = types.CodeInstantiateFailed
-	CodeExecuteFailed     = types.CodeExecuteFailed
-	CodeGasLimit          = types.CodeGasLimit
-	CodeInvalidGenesis    = types.CodeInvalidGenesis
-	CodeNotFound          = types.CodeNotFound
-	ModuleName            = types.ModuleName
-	StoreKey              = types.StoreKey
-	TStoreKey             = types.TStoreKey
-	QuerierRoute          = types.QuerierRoute
-	RouterKey             = types.RouterKey
-	MaxWasmSize           = types.MaxWasmSize
-	DefaultParamspace     = types.DefaultParamspace
-	QueryGetContractInfo  = types.QueryGetContractInfo
-	QueryGetStore         = types.QueryGetStore
-	QueryGetMsg           = types.QueryGetMsg
-	QueryGetCodeInfo      = types.QueryGetCodeInfo
+	DefaultCodespace                = types.DefaultCodespace
+	CodeCreatedFailed               = types.CodeCreatedFailed
+	CodeAccountExists               = types.CodeAccountExists
+	CodeInstantiateFailed           = types.CodeInstantiateFailed
+	CodeExecuteFailed               = types.CodeExecuteFailed
+	CodeGasLimit                    = types.CodeGasLimit
+	CodeInvalidGenesis              = types.CodeInvalidGenesis
+	CodeNotFound                    = types.CodeNotFound
+	ModuleName                      = types.ModuleName
+	StoreKey                        = types.StoreKey
+	TStoreKey                       = types.TStoreKey
+	QuerierRoute                    = types.QuerierRoute
+	RouterKey                       = types.RouterKey
+	MaxWasmSize                     = types.MaxWasmSize
+	DefaultParamspace               = types.DefaultParamspace
+	QueryGetContractInfo            = types.QueryGetContractInfo
+	QueryGetStore                   = types.QueryGetStore
+	QueryGetMsg                     = types.QueryGetMsg
+	QueryGetCodeInfo                = types.QueryGetCodeInfo
+	CodeUnauthorized                = types.CodeUnauthorized
+	CodeUploadDenied                = types.CodeUploadDenied
+	CodeInstantiateDenied           = types.CodeInstantiateDenied
+	AccessTypeEverybody             = types.AccessTypeEverybody
+	AccessTypeNobody                = types.AccessTypeNobody
+	AccessTypeAllowList             = types.AccessTypeAllowList
+	ReplyAlways                     = types.ReplyAlways
+	ReplySuccess                    = types.ReplySuccess
+	ReplyError                      = types.ReplyError
+	ReplyNever                      = types.ReplyNever
+	QueryListCodes                  = types.QueryListCodes
+	QueryListContractsByCode        = types.QueryListContractsByCode
+	QueryListContractsByCreator     = types.QueryListContractsByCreator
+	QueryListModels                 = types.QueryListModels
+	DefaultQueryLimit               = types.DefaultQueryLimit
+	MaxQueryLimit                   = types.MaxQueryLimit
+	MaxLabelSize                    = types.MaxLabelSize
+	MaxSaltSize                     = types.MaxSaltSize
+	ProposalTypeStoreCode           = types.ProposalTypeStoreCode
+	ProposalTypeInstantiateContract = types.ProposalTypeInstantiateContract
+	ProposalTypeExecuteContract     = types.ProposalTypeExecuteContract
 )
 
 var (
 	// functions aliases
-	RegisterCodec        = types.RegisterCodec
-	NewCodeInfo          = types.NewCodeInfo
-	NewWasmAPIParams     = types.NewWasmAPIParams
-	NewWasmCoins         = types.NewWasmCoins
-	NewContractInfo      = types.NewContractInfo
-	ErrCreateFailed      = types.ErrCreateFailed
-	ErrAccountExists     = types.ErrAccountExists
-	ErrInstantiateFailed = types.ErrInstantiateFailed
-	ErrExecuteFailed     = types.ErrExecuteFailed
-	ErrGasLimit          = types.ErrGasLimit
-	ErrInvalidGenesis    = types.ErrInvalidGenesis
-	ErrNotFound          = types.ErrNotFound
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	ValidateGenesis      = types.ValidateGenesis
-	GetCodeInfoKey       = types.GetCodeInfoKey
-	GetContractInfoKey   = types.GetContractInfoKey
-	GetContractStoreKey  = types.GetContractStoreKey
-	DefaultParams        = types.DefaultParams
-	NewKeeper            = keeper.NewKeeper
-	ParamKeyTable        = keeper.ParamKeyTable
-	NewQuerier           = keeper.NewQuerier
-	CreateTestInput      = keeper.CreateTestInput
+	RegisterCodec                    = types.RegisterCodec
+	NewCodeInfo                      = types.NewCodeInfo
+	NewWasmAPIParams                 = types.NewWasmAPIParams
+	NewWasmCoins                     = types.NewWasmCoins
+	NewContractInfo                  = types.NewContractInfo
+	ErrCreateFailed                  = types.ErrCreateFailed
+	ErrAccountExists                 = types.ErrAccountExists
+	ErrInstantiateFailed             = types.ErrInstantiateFailed
+	ErrExecuteFailed                 = types.ErrExecuteFailed
+	ErrGasLimit                      = types.ErrGasLimit
+	ErrInvalidGenesis                = types.ErrInvalidGenesis
+	ErrNotFound                      = types.ErrNotFound
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	ValidateGenesis                  = types.ValidateGenesis
+	GetCodeInfoKey                   = types.GetCodeInfoKey
+	GetContractInfoKey               = types.GetContractInfoKey
+	GetContractStoreKey              = types.GetContractStoreKey
+	DefaultParams                    = types.DefaultParams
+	NewKeeper                        = keeper.NewKeeper
+	ParamKeyTable                    = keeper.ParamKeyTable
+	NewQuerier                       = keeper.NewQuerier
+	CreateTestInput                  = keeper.CreateTestInput
+	ErrUnauthorized                  = types.ErrUnauthorized
+	NewMsgUpdateContractAdmin        = types.NewMsgUpdateContractAdmin
+	NewMsgClearContractAdmin         = types.NewMsgClearContractAdmin
+	NewAllowListAccess               = types.NewAllowListAccess
+	ErrUploadDenied                  = types.ErrUploadDenied
+	ErrInstantiateDenied             = types.ErrInstantiateDenied
+	EncodeTerraMsg                   = keeper.EncodeTerraMsg
+	NewContractLogEvent              = types.NewContractLogEvent
+	NewQueryListParams               = types.NewQueryListParams
+	NewQueryContractsByCodeParams    = types.NewQueryContractsByCodeParams
+	NewQueryContractsByCreatorParams = types.NewQueryContractsByCreatorParams
+	NewQueryModelsParams             = types.NewQueryModelsParams
+	PredictableContractAddress       = types.PredictableContractAddress
+	ContractAddress                  = types.ContractAddress
+	ValidateLabel                    = types.ValidateLabel
+	NewStoreCodeProposal             = types.NewStoreCodeProposal
+	NewInstantiateContractProposal   = types.NewInstantiateContractProposal
+	NewExecuteContractProposal       = types.NewExecuteContractProposal
+	RegisterInvariants               = keeper.RegisterInvariants
+	AllInvariants                    = keeper.AllInvariants
+	ContractInfosInvariant           = keeper.ContractInfosInvariant
+	SequencesInvariant               = keeper.SequencesInvariant
+	NewMsgStoreCode                  = types.NewMsgStoreCode
+	NewMsgInstantiateContract        = types.NewMsgInstantiateContract
+	NewMsgExecuteContract            = types.NewMsgExecuteContract
+	IsReplyMsg                       = types.IsReplyMsg
 
 	// variable aliases
-	ModuleCdc                    = types.ModuleCdc
-	LastCodeIDKey                = types.LastCodeIDKey
-	LastInstanceIDKey            = types.LastInstanceIDKey
-	CodeKey                      = types.CodeKey
-	ContractInfoKey              = types.ContractInfoKey
-	ContractStoreKey             = types.ContractStoreKey
-	ParamStoreKeyMaxContractSize = types.ParamStoreKeyMaxContractSize
-	ParamStoreKeyMaxContractGas  = types.ParamStoreKeyMaxContractGas
-	ParamStoreKeyGasMultiplier   = types.ParamStoreKeyGasMultiplier
-	DefaultMaxContractSize       = types.DefaultMaxContractSize
-	DefaultMaxContractGas        = types.DefaultMaxContractGas
-	DefaultGasMultiplier         = types.DefaultGasMultiplier
+	ModuleCdc                       = types.ModuleCdc
+	LastCodeIDKey                   = types.LastCodeIDKey
+	LastInstanceIDKey               = types.LastInstanceIDKey
+	CodeKey                         = types.CodeKey
+	ContractInfoKey                 = types.ContractInfoKey
+	ContractStoreKey                = types.ContractStoreKey
+	ParamStoreKeyMaxContractSize    = types.ParamStoreKeyMaxContractSize
+	ParamStoreKeyMaxContractGas     = types.ParamStoreKeyMaxContractGas
+	ParamStoreKeyGasMultiplier      = types.ParamStoreKeyGasMultiplier
+	DefaultMaxContractSize          = types.DefaultMaxContractSize
+	DefaultMaxContractGas           = types.DefaultMaxContractGas
+	DefaultGasMultiplier            = types.DefaultGasMultiplier
+	AllowEverybody                  = types.AllowEverybody
+	AllowNobody                     = types.AllowNobody
+	ParamStoreKeyUploadAccess       = types.ParamStoreKeyUploadAccess
+	DefaultUploadAccess             = types.DefaultUploadAccess
+	ParamStoreKeyCompileCostPerByte = types.ParamStoreKeyCompileCostPerByte
+	ParamStoreKeyInstantiateCost    = types.ParamStoreKeyInstantiateCost
+	ParamStoreKeyStoreCostPerByte   = types.ParamStoreKeyStoreCostPerByte
+	ParamStoreKeyDispatchCost       = types.ParamStoreKeyDispatchCost
+	DefaultCompileCostPerByte       = types.DefaultCompileCostPerByte
+	DefaultInstantiateCost          = types.DefaultInstantiateCost
+	DefaultStoreCostPerByte         = types.DefaultStoreCostPerByte
+	DefaultDispatchCost             = types.DefaultDispatchCost
+	MaxCompileCostPerByte           = types.MaxCompileCostPerByte
+	MaxInstantiateCost              = types.MaxInstantiateCost
+	MaxStoreCostPerByte             = types.MaxStoreCostPerByte
+	MaxDispatchCost                 = types.MaxDispatchCost
 )
 
 type (
-	Model                  = types.Model
-	CodeInfo               = types.CodeInfo
-	ContractInfo           = types.ContractInfo
-	GenesisState           = types.GenesisState
-	Code                   = types.Code
-	Contract               = types.Contract
-	MsgStoreCode           = types.MsgStoreCode
-	MsgInstantiateContract = types.MsgInstantiateContract
-	MsgExecuteContract     = types.MsgExecuteContract
-	Params                 = types.Params
-	QueryStoreParams       = types.QueryStoreParams
-	QueryMsgParams         = types.QueryMsgParams
-	Keeper                 = keeper.Keeper
-	InitMsg                = keeper.InitMsg
+	Model                         = types.Model
+	CodeInfo                      = types.CodeInfo
+	ContractInfo                  = types.ContractInfo
+	GenesisState                  = types.GenesisState
+	Code                          = types.Code
+	Contract                      = types.Contract
+	MsgStoreCode                  = types.MsgStoreCode
+	MsgInstantiateContract        = types.MsgInstantiateContract
+	MsgExecuteContract            = types.MsgExecuteContract
+	Params                        = types.Params
+	QueryStoreParams              = types.QueryStoreParams
+	QueryMsgParams                = types.QueryMsgParams
+	Keeper                        = keeper.Keeper
+	InitMsg                       = keeper.InitMsg
+	MsgUpdateContractAdmin        = types.MsgUpdateContractAdmin
+	MsgClearContractAdmin         = types.MsgClearContractAdmin
+	AccessType                    = types.AccessType
+	AccessConfig                  = types.AccessConfig
+	TerraMsg                      = types.TerraMsg
+	SwapMsg                       = types.SwapMsg
+	DelegateMsg                   = types.DelegateMsg
+	UndelegateMsg                 = types.UndelegateMsg
+	RedelegateMsg                 = types.RedelegateMsg
+	WithdrawDelegatorRewardMsg    = types.WithdrawDelegatorRewardMsg
+	VoteMsg                       = types.VoteMsg
+	SubMsg                        = types.SubMsg
+	SubMsgResult                  = types.SubMsgResult
+	SubMsgExecutionResponse       = types.SubMsgExecutionResponse
+	Reply                         = types.Reply
+	ReplyMsg                      = types.ReplyMsg
+	LogAttribute                  = types.LogAttribute
+	QueryListParams               = types.QueryListParams
+	QueryContractsByCodeParams    = types.QueryContractsByCodeParams
+	QueryContractsByCreatorParams = types.QueryContractsByCreatorParams
+	QueryModelsParams             = types.QueryModelsParams
+	CodeInfoResponse              = types.CodeInfoResponse
+	CodeInfoResponses             = types.CodeInfoResponses
+	ContractInfos                 = types.ContractInfos
+	ModelsResponse                = types.ModelsResponse
+	StoreCodeProposal             = types.StoreCodeProposal
+	InstantiateContractProposal   = types.InstantiateContractProposal
+	ExecuteContractProposal       = types.ExecuteContractProposal
 )
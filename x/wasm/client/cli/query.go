@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,7 +19,12 @@ import (
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
-const flagRaw = "raw"
+const (
+	flagRaw      = "raw"
+	flagPage     = "page"
+	flagLimit    = "limit"
+	flagStartKey = "start-key"
+)
 
 // GetQueryCmd returns the cli query commands for wasm   module
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -34,6 +41,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdGetContractInfo(cdc),
 		GetCmdGetStore(cdc),
 		GetCmdGetMsg(cdc),
+		GetCmdListCodes(cdc),
+		GetCmdListContractsByCode(cdc),
+		GetCmdListContractsByCreator(cdc),
+		GetCmdListModels(cdc),
+		GetCmdPredictContractAddress(cdc),
 	)...)
 	return queryCmd
 }
@@ -222,11 +234,206 @@ func GetCmdGetStore(cdc *codec.Codec) *cobra.Command {
 			}
 
 			model := types.Model{
-				Key:   string(keyBz),
-				Value: string(res),
+				Key:   keyBz,
+				Value: res,
 			}
 
 			return cliCtx.PrintOutput(model)
 		},
 	}
 }
+
+// GetCmdListCodes lists the uploaded codes
+func GetCmdListCodes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codes",
+		Short: "List the uploaded codes with their creator and hash",
+		Long:  "List the uploaded codes with their creator and hash",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryListParams(viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryListCodes)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var codes types.CodeInfoResponses
+			cdc.MustUnmarshalJSON(res, &codes)
+			return cliCtx.PrintOutput(codes)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "pagination page of codes to query for")
+	cmd.Flags().Int(flagLimit, types.DefaultQueryLimit, "pagination limit of codes to query for")
+	return cmd
+}
+
+// GetCmdListContractsByCode lists the contracts instantiated from a given code
+func GetCmdListContractsByCode(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-code [code-id]",
+		Short: "List the contracts instantiated from a code given its id",
+		Long:  "List the contracts instantiated from a code given its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryContractsByCodeParams(codeID, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryListContractsByCode)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var contracts types.ContractInfos
+			cdc.MustUnmarshalJSON(res, &contracts)
+			return cliCtx.PrintOutput(contracts)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "pagination page of contracts to query for")
+	cmd.Flags().Int(flagLimit, types.DefaultQueryLimit, "pagination limit of contracts to query for")
+	return cmd
+}
+
+// GetCmdListContractsByCreator lists the contracts instantiated by a given creator
+func GetCmdListContractsByCreator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-creator [creator-address]",
+		Short: "List the contracts instantiated by a creator given its address",
+		Long:  "List the contracts instantiated by a creator given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryContractsByCreatorParams(creator, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryListContractsByCreator)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var contracts types.ContractInfos
+			cdc.MustUnmarshalJSON(res, &contracts)
+			return cliCtx.PrintOutput(contracts)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "pagination page of contracts to query for")
+	cmd.Flags().Int(flagLimit, types.DefaultQueryLimit, "pagination limit of contracts to query for")
+	return cmd
+}
+
+// GetCmdListModels lists the models in the store of a given contract
+func GetCmdListModels(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "models [bech32-address]",
+		Short: "List the key/value models in the store of a contract given its address",
+		Long: `List the key/value models in the store of a contract given its address.
+Keys and values are base64 encoded. Pass the next_key of the previous page
+as --start-key to query the following page.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startKey, err := base64.StdEncoding.DecodeString(viper.GetString(flagStartKey))
+			if err != nil {
+				return fmt.Errorf("start key is not base64 encoded: %s", err)
+			}
+
+			params := types.NewQueryModelsParams(addr, startKey, viper.GetInt(flagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryListModels)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var models types.ModelsResponse
+			cdc.MustUnmarshalJSON(res, &models)
+			return cliCtx.PrintOutput(models)
+		},
+	}
+
+	cmd.Flags().String(flagStartKey, "", "base64 encoded key to start listing from, the next_key of the previous page")
+	cmd.Flags().Int(flagLimit, types.DefaultQueryLimit, "pagination limit of models to query for")
+	return cmd
+}
+
+// GetCmdPredictContractAddress prints the address of a contract instantiated with a salt
+func GetCmdPredictContractAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "predict-address [creator-address] [code-id] [salt]",
+		Short: "Prints out the address of a contract instantiated by the creator from the code with the salt",
+		Long:  "Prints out the address of a contract instantiated by the creator from the code with the salt",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryCodeIDParams(codeID)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGetCodeInfo)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var codeInfo types.CodeInfo
+			cdc.MustUnmarshalJSON(res, &codeInfo)
+
+			fmt.Println(types.PredictableContractAddress(creator, codeInfo.CodeHash, []byte(args[2])).String())
+			return nil
+		},
+	}
+}
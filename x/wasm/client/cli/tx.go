@@ -4,15 +4,19 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	wasmUtils "github.com/terra-project/core/x/wasm/client/utils"
 	"github.com/terra-project/core/x/wasm/internal/types"
@@ -21,6 +25,11 @@ import (
 const (
 	flagTo     = "to"
 	flagAmount = "amount"
+	flagAdmin  = "admin"
+	flagLabel  = "label"
+	flagSalt   = "salt"
+
+	flagInstantiatePermission = "instantiate-permission"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -36,6 +45,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		StoreCodeCmd(cdc),
 		InstantiateContractCmd(cdc),
 		ExecuteContractCmd(cdc),
+		UpdateContractAdminCmd(cdc),
+		ClearContractAdminCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -50,41 +61,81 @@ func StoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			// parse coins trying to be sent
-			wasm, err := ioutil.ReadFile(args[1])
+			wasm, err := readWasmFile(args[1])
 			if err != nil {
 				return err
 			}
 
-			// limit the input size
-			if len(wasm) > types.MaxWasmSize {
-				return fmt.Errorf("input size exceeds the max size hard-cap (allowed:%d, actual: %d)",
-					types.MaxWasmSize, len(wasm))
-			}
-
-			// gzip the wasm file
-			if wasmUtils.IsWasm(wasm) {
-				wasm, err = wasmUtils.GzipIt(wasm)
-
+			var instantiatePermission *types.AccessConfig
+			if permissionStr := viper.GetString(flagInstantiatePermission); len(permissionStr) != 0 {
+				permission, err := parseAccessConfig(permissionStr)
 				if err != nil {
 					return err
 				}
-			} else if !wasmUtils.IsGzip(wasm) {
-				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
+				instantiatePermission = &permission
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.MsgStoreCode{
-				Sender:       cliCtx.GetFromAddress(),
-				WASMBytecode: wasm,
+				Sender:                cliCtx.GetFromAddress(),
+				WASMBytecode:          wasm,
+				InstantiatePermission: instantiatePermission,
 			}
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagInstantiatePermission, "",
+		"who may instantiate the code: everybody, nobody or comma separated addresses (default everybody)")
+
 	return cmd
 }
 
+// readWasmFile reads a wasm binary or gzip file, gzipping the wasm binary
+func readWasmFile(file string) ([]byte, error) {
+	wasm, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// limit the input size
+	if len(wasm) > types.MaxWasmSize {
+		return nil, fmt.Errorf("input size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+			types.MaxWasmSize, len(wasm))
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasm) {
+		return wasmUtils.GzipIt(wasm)
+	} else if !wasmUtils.IsGzip(wasm) {
+		return nil, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+
+	return wasm, nil
+}
+
+// parseAccessConfig parses "everybody", "nobody" or a comma separated address list
+func parseAccessConfig(str string) (types.AccessConfig, error) {
+	switch types.AccessType(str) {
+	case types.AccessTypeEverybody:
+		return types.AllowEverybody, nil
+	case types.AccessTypeNobody:
+		return types.AllowNobody, nil
+	}
+
+	var addrs []sdk.AccAddress
+	for _, addrStr := range strings.Split(str, ",") {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(addrStr))
+		if err != nil {
+			return types.AccessConfig{}, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return types.NewAllowListAccess(addrs...), nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -111,17 +162,41 @@ func InstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 				}
 			}
 
+			var admin sdk.AccAddress
+			if adminStr := viper.GetString(flagAdmin); len(adminStr) != 0 {
+				admin, err = sdk.AccAddressFromBech32(adminStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var salt []byte
+			if saltStr := viper.GetString(flagSalt); len(saltStr) != 0 {
+				salt = []byte(saltStr)
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.MsgInstantiateContract{
 				Sender:    cliCtx.GetFromAddress(),
+				Admin:     admin,
 				CodeID:    codeID,
 				InitCoins: coins,
 				InitMsg:   []byte(initMsg),
+				Label:     viper.GetString(flagLabel),
+				Salt:      salt,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	cmd.Flags().String(flagAdmin, "", "address allowed to change or clear the admin of the contract")
+	cmd.Flags().String(flagLabel, "", "human readable name of the contract")
+	cmd.Flags().String(flagSalt, "", "salt to derive a predictable contract address from, instead of the instance counter")
+	cmd.MarkFlagRequired(flagLabel)
+
 	return cmd
 }
 
@@ -164,3 +239,237 @@ func ExecuteContractCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// UpdateContractAdminCmd will hand over the admin of a contract
+func UpdateContractAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-admin [from_key_or_address] [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short: "Set a new admin for a wasm contract",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUpdateContractAdmin(cliCtx.GetFromAddress(), newAdmin, contractAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// ClearContractAdminCmd will remove the admin of a contract
+func ClearContractAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-contract-admin [from_key_or_address] [contract_addr_bech32]",
+		Short: "Clear the admin of a wasm contract for good",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgClearContractAdmin(cliCtx.GetFromAddress(), contractAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitStoreCodeProposal implements the command to submit a store-code proposal
+func GetCmdSubmitStoreCodeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-code [wasm-file] [proposal-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a store code proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a store code proposal along with an initial deposit.
+The wasm binary or gzip file is uploaded regardless of the upload access once the proposal passed;
+the proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal store-code <path/to/contract.wasm> <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Store Token Contract",
+  "description": "Lets upload the audited token contract",
+  "run_as": "terra1...",
+  "instantiate_permission": {
+    "type": "everybody"
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			wasm, err := readWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseStoreCodeProposalJSON(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewStoreCodeProposal(proposal.Title, proposal.Description, proposal.RunAs, wasm, proposal.InstantiatePermission)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitInstantiateContractProposal implements the command to submit an instantiate-contract proposal
+func GetCmdSubmitInstantiateContractProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-contract [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an instantiate contract proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an instantiate contract proposal along with an initial deposit.
+The code is instantiated regardless of its instantiate permission once the proposal passed;
+no coins are sent to the contract. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal instantiate-contract <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Instantiate Token Contract",
+  "description": "Lets instantiate the token contract",
+  "run_as": "terra1...",
+  "admin": "terra1...",
+  "code_id": "1",
+  "label": "token",
+  "init_msg": {"name": "token"},
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseInstantiateContractProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewInstantiateContractProposal(proposal.Title, proposal.Description, proposal.RunAs, proposal.Admin,
+				proposal.CodeID, proposal.Label, proposal.InitMsg)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitExecuteContractProposal implements the command to submit an execute-contract proposal
+func GetCmdSubmitExecuteContractProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-contract [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an execute contract proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an execute contract proposal along with an initial deposit.
+The contract is executed with the run as address as sender once the proposal passed;
+no coins are sent to the contract. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal execute-contract <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Pause Token Contract",
+  "description": "Lets pause the token contract",
+  "run_as": "terra1...",
+  "contract": "terra1...",
+  "msg": {"pause": {}},
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseExecuteContractProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewExecuteContractProposal(proposal.Title, proposal.Description, proposal.RunAs,
+				proposal.Contract, proposal.Msg)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
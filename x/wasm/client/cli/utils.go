@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+type (
+	// StoreCodeProposalJSON defines a StoreCodeProposal with a deposit; the wasm code is read from a separate file
+	StoreCodeProposalJSON struct {
+		Title                 string              `json:"title" yaml:"title"`
+		Description           string              `json:"description" yaml:"description"`
+		RunAs                 sdk.AccAddress      `json:"run_as" yaml:"run_as"`
+		InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+		Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// InstantiateContractProposalJSON defines an InstantiateContractProposal with a deposit
+	InstantiateContractProposalJSON struct {
+		Title       string          `json:"title" yaml:"title"`
+		Description string          `json:"description" yaml:"description"`
+		RunAs       sdk.AccAddress  `json:"run_as" yaml:"run_as"`
+		Admin       sdk.AccAddress  `json:"admin" yaml:"admin"`
+		CodeID      uint64          `json:"code_id" yaml:"code_id"`
+		Label       string          `json:"label" yaml:"label"`
+		InitMsg     json.RawMessage `json:"init_msg" yaml:"init_msg"`
+		Deposit     sdk.Coins       `json:"deposit" yaml:"deposit"`
+	}
+
+	// ExecuteContractProposalJSON defines an ExecuteContractProposal with a deposit
+	ExecuteContractProposalJSON struct {
+		Title       string          `json:"title" yaml:"title"`
+		Description string          `json:"description" yaml:"description"`
+		RunAs       sdk.AccAddress  `json:"run_as" yaml:"run_as"`
+		Contract    sdk.AccAddress  `json:"contract" yaml:"contract"`
+		Msg         json.RawMessage `json:"msg" yaml:"msg"`
+		Deposit     sdk.Coins       `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseStoreCodeProposalJSON reads and parses a StoreCodeProposalJSON from a file.
+func ParseStoreCodeProposalJSON(cdc *codec.Codec, proposalFile string) (StoreCodeProposalJSON, error) {
+	proposal := StoreCodeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseInstantiateContractProposalJSON reads and parses an InstantiateContractProposalJSON from a file.
+func ParseInstantiateContractProposalJSON(cdc *codec.Codec, proposalFile string) (InstantiateContractProposalJSON, error) {
+	proposal := InstantiateContractProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseExecuteContractProposalJSON reads and parses an ExecuteContractProposalJSON from a file.
+func ParseExecuteContractProposalJSON(cdc *codec.Codec, proposalFile string) (ExecuteContractProposalJSON, error) {
+	proposal := ExecuteContractProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
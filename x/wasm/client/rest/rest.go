@@ -4,6 +4,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
 const (
@@ -12,6 +13,9 @@ const (
 	RestKey             = "key"
 	RestSubkey          = "subkey"
 	RestMsg             = "msg"
+	RestCreator         = "creator"
+	RestStartKey        = "start_key"
+	RestLimit           = "limit"
 )
 
 // RegisterRoutes registers staking-related REST handlers to a router
@@ -19,3 +23,27 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 	registerTxRoutes(cliCtx, r)
 }
+
+// StoreCodeProposalRESTHandler returns a ProposalRESTHandler that exposes the store code REST handler with a given sub-route.
+func StoreCodeProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_store_code",
+		Handler:  postStoreCodeProposalHandlerFn(cliCtx),
+	}
+}
+
+// InstantiateContractProposalRESTHandler returns a ProposalRESTHandler that exposes the instantiate contract REST handler with a given sub-route.
+func InstantiateContractProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_instantiate_contract",
+		Handler:  postInstantiateContractProposalHandlerFn(cliCtx),
+	}
+}
+
+// ExecuteContractProposalRESTHandler returns a ProposalRESTHandler that exposes the execute contract REST handler with a given sub-route.
+func ExecuteContractProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_execute_contract",
+		Handler:  postExecuteContractProposalHandlerFn(cliCtx),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/gorilla/mux"
 
 	wasmUtils "github.com/terra-project/core/x/wasm/client/utils"
@@ -19,20 +20,26 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/wasm/code/", storeCodeHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/wasm/code/{%s}", RestCodeID), instantiateContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}", RestContractAddress), executeContractHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}/admin", RestContractAddress), updateContractAdminHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}/admin/clear", RestContractAddress), clearContractAdminHandlerFn(cliCtx)).Methods("POST")
 }
 
 // limit max bytes read to prevent gzip bombs
 const maxSize = 400 * 1024
 
 type storeCodeReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	WasmBytes []byte       `json:"wasm_bytes"`
+	BaseReq               rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	WasmBytes             []byte              `json:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
 }
 
 type instantiateContractReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	InitCoins sdk.Coins    `json:"init_coins" yaml:"init_coins"`
-	InitMsg   []byte       `json:"init_msg" yaml:"init_msg"`
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Admin     sdk.AccAddress `json:"admin" yaml:"admin"`
+	InitCoins sdk.Coins      `json:"init_coins" yaml:"init_coins"`
+	InitMsg   []byte         `json:"init_msg" yaml:"init_msg"`
+	Label     string         `json:"label" yaml:"label"`
+	Salt      []byte         `json:"salt" yaml:"salt"`
 }
 
 type executeContractReq struct {
@@ -41,6 +48,79 @@ type executeContractReq struct {
 	Amount  sdk.Coins    `json:"coins" yaml:"coins"`
 }
 
+type updateContractAdminReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+type clearContractAdminReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// StoreCodeProposalReq defines a store-code proposal request body.
+type StoreCodeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title                 string              `json:"title" yaml:"title"`
+	Description           string              `json:"description" yaml:"description"`
+	RunAs                 sdk.AccAddress      `json:"run_as" yaml:"run_as"`
+	WasmBytes             []byte              `json:"wasm_bytes" yaml:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+	Proposer              sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+	Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+}
+
+// InstantiateContractProposalReq defines an instantiate-contract proposal request body.
+type InstantiateContractProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	RunAs       sdk.AccAddress `json:"run_as" yaml:"run_as"`
+	Admin       sdk.AccAddress `json:"admin" yaml:"admin"`
+	CodeID      uint64         `json:"code_id" yaml:"code_id"`
+	Label       string         `json:"label" yaml:"label"`
+	InitMsg     []byte         `json:"init_msg" yaml:"init_msg"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ExecuteContractProposalReq defines an execute-contract proposal request body.
+type ExecuteContractProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	RunAs       sdk.AccAddress `json:"run_as" yaml:"run_as"`
+	Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+	ExecMsg     []byte         `json:"exec_msg" yaml:"exec_msg"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// gzipWasm gzips a wasm binary, writing an error response for oversized or invalid input
+func gzipWasm(w http.ResponseWriter, wasm []byte) ([]byte, bool) {
+	if len(wasm) > maxSize {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Binary size exceeds maximum limit")
+		return nil, false
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasm) {
+		wasm, err := wasmUtils.GzipIt(wasm)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return nil, false
+		}
+		return wasm, true
+	} else if !wasmUtils.IsGzip(wasm) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+		return nil, false
+	}
+
+	return wasm, true
+}
+
 func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req storeCodeReq
@@ -53,22 +133,8 @@ func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var err error
-		wasm := req.WasmBytes
-		if len(wasm) > maxSize {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "Binary size exceeds maximum limit")
-			return
-		}
-
-		// gzip the wasm file
-		if wasmUtils.IsWasm(wasm) {
-			wasm, err = wasmUtils.GzipIt(wasm)
-			if err != nil {
-				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
-		} else if !wasmUtils.IsGzip(wasm) {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+		wasm, ok := gzipWasm(w, req.WasmBytes)
+		if !ok {
 			return
 		}
 
@@ -79,8 +145,9 @@ func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 		// build and sign the transaction, then broadcast to Tendermint
 		msg := types.MsgStoreCode{
-			Sender:       fromAddr,
-			WASMBytecode: wasm,
+			Sender:                fromAddr,
+			WASMBytecode:          wasm,
+			InstantiatePermission: req.InstantiatePermission,
 		}
 
 		err = msg.ValidateBasic()
@@ -115,9 +182,12 @@ func instantiateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		msg := types.MsgInstantiateContract{
 			Sender:    cliCtx.GetFromAddress(),
+			Admin:     req.Admin,
 			CodeID:    codeID,
 			InitCoins: req.InitCoins,
 			InitMsg:   req.InitMsg,
+			Label:     req.Label,
+			Salt:      req.Salt,
 		}
 
 		err = msg.ValidateBasic()
@@ -166,3 +236,157 @@ func executeContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func updateContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req updateContractAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+		contractAddr := vars[RestContractAddress]
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUpdateContractAdmin(fromAddr, req.NewAdmin, contractAddress)
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func clearContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req clearContractAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+		contractAddr := vars[RestContractAddress]
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClearContractAdmin(fromAddr, contractAddress)
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postStoreCodeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req StoreCodeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		wasm, ok := gzipWasm(w, req.WasmBytes)
+		if !ok {
+			return
+		}
+
+		content := types.NewStoreCodeProposal(req.Title, req.Description, req.RunAs, wasm, req.InstantiatePermission)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postInstantiateContractProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req InstantiateContractProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewInstantiateContractProposal(req.Title, req.Description, req.RunAs, req.Admin,
+			req.CodeID, req.Label, req.InitMsg)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postExecuteContractProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecuteContractProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewExecuteContractProposal(req.Title, req.Description, req.RunAs, req.Contract, req.ExecMsg)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -1,12 +1,8 @@
 package wasm
 
 import (
-	"bytes"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/terra-project/core/x/wasm/internal/types"
-	// authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
-	// "github.com/terra-project/core/x/wasm/internal/types"
 )
 
 // InitGenesis sets wasm information for genesis.
@@ -16,18 +12,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
 	for _, code := range data.Codes {
-		newCodeID, err := keeper.StoreCode(ctx, code.CodeInfo.Creator, code.CodesBytes)
-		if err != nil {
-			panic(err)
-		}
-		newInfo, err := keeper.GetCodeInfo(ctx, newCodeID)
-		if err != nil {
+		if err := keeper.ImportCode(ctx, code.CodeID, code.CodeInfo, code.CodesBytes); err != nil {
 			panic(err)
 		}
-
-		if !bytes.Equal(code.CodeInfo.CodeHash, newInfo.CodeHash) {
-			panic("code hashes not same")
-		}
 	}
 
 	for _, contract := range data.Contracts {
@@ -35,6 +22,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetContractStore(ctx, contract.ContractInfo.Address, contract.ContractStore)
 	}
 
+	// unused sequences are left unset, as they are on a fresh chain
+	if data.LastCodeID > 0 {
+		keeper.SetNextCodeID(ctx, data.LastCodeID+1)
+	}
+	if data.LastInstanceID > 0 {
+		keeper.SetNextInstanceID(ctx, data.LastInstanceID+1)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -42,14 +36,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	var codes []types.Code
 	var contracts []types.Contract
 
-	maxCodeID := keeper.GetNextCodeID(ctx)
-	for i := uint64(1); i < maxCodeID; i++ {
-		bytecode, err := keeper.GetBytecode(ctx, i)
-		if err != nil {
-			panic(err)
-		}
-
-		codeInfo, err := keeper.GetCodeInfo(ctx, i)
+	keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		bytecode, err := keeper.GetBytecode(ctx, codeID)
 		if err != nil {
 			panic(err)
 		}
@@ -57,16 +45,19 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		codes = append(codes, types.Code{
 			CodeInfo:   codeInfo,
 			CodesBytes: bytecode,
+			CodeID:     codeID,
 		})
-	}
+
+		return false
+	})
 
 	keeper.IterateContractInfo(ctx, func(contract types.ContractInfo) bool {
 		contractStateIterator := keeper.GetContractStoreIterator(ctx, contract.Address)
 		var models []types.Model
 		for ; contractStateIterator.Valid(); contractStateIterator.Next() {
 			m := types.Model{
-				Key:   string(contractStateIterator.Key()),
-				Value: string(contractStateIterator.Value()),
+				Key:   contractStateIterator.Key(),
+				Value: contractStateIterator.Value(),
 			}
 			models = append(models, m)
 		}
@@ -81,5 +72,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	params := keeper.GetParams(ctx)
 
-	return types.NewGenesisState(params, codes, contracts)
+	lastCodeID := keeper.GetNextCodeID(ctx) - 1
+	lastInstanceID := keeper.GetNextInstanceID(ctx) - 1
+
+	return types.NewGenesisState(params, codes, contracts, lastCodeID, lastInstanceID)
 }
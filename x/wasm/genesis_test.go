@@ -46,6 +46,7 @@ func TestInitGenesis(t *testing.T) {
 		CodeID:    1,
 		InitMsg:   initMsgBz,
 		InitCoins: deposit,
+		Label:     "demo contract",
 	}
 	res = h(data.ctx, initCmd)
 	require.True(t, res.IsOK())
@@ -82,7 +83,7 @@ func TestInitGenesis(t *testing.T) {
 	require.NoError(t, sdkErr)
 	require.Equal(t, testContract, bytecode)
 
-	expectedContractInfo := NewContractInfo(1, contractAddr, creator, initMsgBz)
+	expectedContractInfo := NewContractInfo(1, 1, contractAddr, creator, nil, "demo contract", initMsgBz)
 	contractInfo, sdkErr := data.keeper.GetContractInfo(data.ctx, contractAddr)
 	require.NoError(t, sdkErr)
 	require.Equal(t, expectedContractInfo, contractInfo)
@@ -90,7 +91,7 @@ func TestInitGenesis(t *testing.T) {
 	iter := data.keeper.GetContractStoreIterator(data.ctx, contractAddr)
 	var models []Model
 	for ; iter.Valid(); iter.Next() {
-		models = append(models, Model{Key: string(iter.Key()), Value: string(iter.Value())})
+		models = append(models, Model{Key: iter.Key(), Value: iter.Value()})
 	}
 
 	expectedConfigState := state{
@@ -103,6 +104,37 @@ func TestInitGenesis(t *testing.T) {
 
 	// export into genstate
 	genState := ExportGenesis(data.ctx, data.keeper)
+	require.NoError(t, ValidateGenesis(genState))
+
+	// the same contract address twice is rejected
+	invalidState := genState
+	invalidState.Contracts = append(append([]Contract{}, genState.Contracts...), genState.Contracts[0])
+	require.Error(t, ValidateGenesis(invalidState))
+
+	require.Equal(t, uint64(1), genState.LastCodeID)
+	require.Equal(t, uint64(1), genState.LastInstanceID)
+	require.Equal(t, uint64(1), genState.Codes[0].CodeID)
+
+	// codes beyond the last code ID and contracts of unknown codes are rejected
+	invalidState = genState
+	invalidState.LastCodeID = 0
+	require.Error(t, ValidateGenesis(invalidState))
+
+	invalidState = genState
+	invalidState.Codes = []Code{}
+	require.Error(t, ValidateGenesis(invalidState))
+
+	// the instance counter must be past the counter addressed contracts
+	invalidState = genState
+	invalidState.LastInstanceID = 0
+	require.Error(t, ValidateGenesis(invalidState))
+
+	// and the contract address must be derived from its code and instance ID
+	invalidState = genState
+	invalidState.LastInstanceID = 2
+	invalidState.Contracts = append([]Contract{}, genState.Contracts...)
+	invalidState.Contracts[0].ContractInfo.InstanceID = 2
+	require.Error(t, ValidateGenesis(invalidState))
 
 	// create new app to import genstate into
 	newData, newCleanup := setupTest(t)
@@ -112,18 +144,23 @@ func TestInitGenesis(t *testing.T) {
 	InitGenesis(newData.ctx, newData.keeper, genState)
 
 	// run same checks again on newdata, to make sure it was reinitialized correctly
-	bytecode, err = data.keeper.GetBytecode(data.ctx, 1)
+	bytecode, err = newData.keeper.GetBytecode(newData.ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, testContract, bytecode)
 
-	contractInfo, err = data.keeper.GetContractInfo(data.ctx, contractAddr)
+	contractInfo, err = newData.keeper.GetContractInfo(newData.ctx, contractAddr)
 	require.NoError(t, err)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
-	iter = data.keeper.GetContractStoreIterator(data.ctx, contractAddr)
+	// the sequences continue where the exported chain stopped
+	require.Equal(t, uint64(2), newData.keeper.GetNextCodeID(newData.ctx))
+	require.Equal(t, uint64(2), newData.keeper.GetNextInstanceID(newData.ctx))
+	require.Equal(t, genState, ExportGenesis(newData.ctx, newData.keeper))
+
+	iter = newData.keeper.GetContractStoreIterator(newData.ctx, contractAddr)
 	models = []Model{}
 	for ; iter.Valid(); iter.Next() {
-		models = append(models, Model{Key: string(iter.Key()), Value: string(iter.Value())})
+		models = append(models, Model{Key: iter.Key(), Value: iter.Value()})
 	}
 
 	assertContractStore(t, models, expectedConfigState)
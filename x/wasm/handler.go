@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
@@ -25,6 +27,14 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleExecute(ctx, k, msg)
 		case *MsgExecuteContract:
 			return handleExecute(ctx, k, *msg)
+		case MsgUpdateContractAdmin:
+			return handleUpdateContractAdmin(ctx, k, msg)
+		case *MsgUpdateContractAdmin:
+			return handleUpdateContractAdmin(ctx, k, *msg)
+		case MsgClearContractAdmin:
+			return handleClearContractAdmin(ctx, k, msg)
+		case *MsgClearContractAdmin:
+			return handleClearContractAdmin(ctx, k, *msg)
 
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
@@ -34,7 +44,12 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleStoreCode(ctx sdk.Context, k Keeper, msg MsgStoreCode) sdk.Result {
-	codeID, err := k.StoreCode(ctx, msg.Sender, msg.WASMBytecode)
+	instantiatePermission := types.AllowEverybody
+	if msg.InstantiatePermission != nil {
+		instantiatePermission = *msg.InstantiatePermission
+	}
+
+	codeID, err := k.StoreCode(ctx, msg.Sender, msg.WASMBytecode, instantiatePermission)
 	if err != nil {
 		return err.Result()
 	}
@@ -57,7 +72,13 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg MsgStoreCode) sdk.Result {
 }
 
 func handleInstantiate(ctx sdk.Context, k Keeper, msg MsgInstantiateContract) sdk.Result {
-	contractAddr, err := k.InstantiateContract(ctx, msg.Sender, msg.CodeID, msg.InitMsg, msg.InitCoins)
+	var contractAddr sdk.AccAddress
+	var err sdk.Error
+	if len(msg.Salt) != 0 {
+		contractAddr, err = k.InstantiateContractWithSalt(ctx, msg.Sender, msg.Admin, msg.CodeID, msg.Label, msg.Salt, msg.InitMsg, msg.InitCoins)
+	} else {
+		contractAddr, err = k.InstantiateContract(ctx, msg.Sender, msg.Admin, msg.CodeID, msg.Label, msg.InitMsg, msg.InitCoins)
+	}
 	if err != nil {
 		return err.Result()
 	}
@@ -77,11 +98,11 @@ func handleInstantiate(ctx sdk.Context, k Keeper, msg MsgInstantiateContract) sd
 		},
 	)
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	return sdk.Result{Data: contractAddr, Events: ctx.EventManager().Events()}
 }
 
 func handleExecute(ctx sdk.Context, k Keeper, msg MsgExecuteContract) sdk.Result {
-	err := k.ExecuteContract(ctx, msg.Contract, msg.Sender, msg.Coins, msg.Msg)
+	data, err := k.ExecuteContract(ctx, msg.Contract, msg.Sender, msg.Coins, msg.Msg)
 	if err != nil {
 		return err.Result()
 	}
@@ -100,5 +121,112 @@ func handleExecute(ctx sdk.Context, k Keeper, msg MsgExecuteContract) sdk.Result
 		},
 	)
 
+	return sdk.Result{Data: data, Events: ctx.EventManager().Events()}
+}
+
+func handleUpdateContractAdmin(ctx sdk.Context, k Keeper, msg MsgUpdateContractAdmin) sdk.Result {
+	err := k.UpdateContractAdmin(ctx, msg.Contract, msg.Admin, msg.NewAdmin)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeUpdateContractAdmin,
+				sdk.NewAttribute(types.AttributeKeyAdmin, msg.NewAdmin.String()),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract.String()),
+			),
+		},
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleClearContractAdmin(ctx sdk.Context, k Keeper, msg MsgClearContractAdmin) sdk.Result {
+	err := k.ClearContractAdmin(ctx, msg.Contract, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeClearContractAdmin,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract.String()),
+			),
+		},
+	)
+
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// NewWasmProposalHandler custom gov proposal handler
+func NewWasmProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		// passed proposals act regardless of access configs and contract admins
+		k := k.WithGovAuthorization()
+
+		switch c := content.(type) {
+		case StoreCodeProposal:
+			return handleStoreCodeProposal(ctx, k, c)
+		case InstantiateContractProposal:
+			return handleInstantiateContractProposal(ctx, k, c)
+		case ExecuteContractProposal:
+			return handleExecuteContractProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized wasm proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleStoreCodeProposal is a handler for uploading wasm code
+func handleStoreCodeProposal(ctx sdk.Context, k Keeper, p StoreCodeProposal) sdk.Error {
+	instantiatePermission := types.AllowEverybody
+	if p.InstantiatePermission != nil {
+		instantiatePermission = *p.InstantiatePermission
+	}
+
+	codeID, err := k.StoreCode(ctx, p.RunAs, p.WASMByteCode, instantiatePermission)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("stored code %d created by %s", codeID, p.RunAs))
+	return nil
+}
+
+// handleInstantiateContractProposal is a handler for instantiating wasm code
+func handleInstantiateContractProposal(ctx sdk.Context, k Keeper, p InstantiateContractProposal) sdk.Error {
+	contractAddr, err := k.InstantiateContract(ctx, p.RunAs, p.Admin, p.CodeID, p.Label, p.InitMsg, nil)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("instantiated code %d at %s", p.CodeID, contractAddr))
+	return nil
+}
+
+// handleExecuteContractProposal is a handler for executing a contract
+func handleExecuteContractProposal(ctx sdk.Context, k Keeper, p ExecuteContractProposal) sdk.Error {
+	_, err := k.ExecuteContract(ctx, p.Contract, p.RunAs, nil, p.Msg)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("executed contract %s as %s", p.Contract, p.RunAs))
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/terra-project/core/x/wasm/internal/types"
@@ -103,6 +104,7 @@ func TestHandleInstantiate(t *testing.T) {
 		CodeID:    1,
 		InitMsg:   initMsgBz,
 		InitCoins: nil,
+		Label:     "demo contract",
 	}
 	res = h(data.ctx, initCmd)
 	fmt.Print(res.Log)
@@ -124,13 +126,13 @@ func TestHandleInstantiate(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, 1, contractAddr, creator, nil, "demo contract", initMsgBz)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	iter := data.keeper.GetContractStoreIterator(data.ctx, contractAddr)
 	var models []Model
 	for ; iter.Valid(); iter.Next() {
-		models = append(models, Model{Key: string(iter.Key()), Value: string(iter.Value())})
+		models = append(models, Model{Key: iter.Key(), Value: iter.Value()})
 	}
 
 	expectedConfigStore := state{
@@ -177,9 +179,11 @@ func TestHandleExecute(t *testing.T) {
 		CodeID:    1,
 		InitMsg:   initMsgBz,
 		InitCoins: deposit,
+		Label:     "demo contract",
 	}
 	res = h(data.ctx, initCmd)
 	require.True(t, res.IsOK())
+	requireContractEvent(t, res.Events)
 
 	var contractAddr sdk.AccAddress
 	for _, event := range res.Events {
@@ -195,9 +199,10 @@ func TestHandleExecute(t *testing.T) {
 	}
 
 	require.False(t, contractAddr.Empty())
+	require.Equal(t, []byte(contractAddr), res.Data)
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, 1, contractAddr, creator, nil, "demo contract", initMsgBz)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	// ensure bob doesn't exist
@@ -223,6 +228,16 @@ func TestHandleExecute(t *testing.T) {
 	}
 	res = h(data.ctx, execCmd)
 	require.True(t, res.IsOK())
+	require.Equal(t, contractAddr.String(), requireContractEvent(t, res.Events))
+
+	// the transfer the contract dispatched is in the result along with the top up
+	var recipients []string
+	for _, event := range res.Events {
+		if event.Type == bank.EventTypeTransfer {
+			recipients = append(recipients, string(event.Attributes[0].GetValue()))
+		}
+	}
+	require.Equal(t, []string{contractAddr.String(), bob.String()}, recipients)
 
 	// ensure bob now exists and got both payments released
 	bobAcct = data.acctKeeper.GetAccount(data.ctx, bob)
@@ -238,7 +253,7 @@ func TestHandleExecute(t *testing.T) {
 	iter := data.keeper.GetContractStoreIterator(data.ctx, contractAddr)
 	var models []Model
 	for ; iter.Valid(); iter.Next() {
-		models = append(models, Model{Key: string(iter.Key()), Value: string(iter.Value())})
+		models = append(models, Model{Key: iter.Key(), Value: iter.Value()})
 	}
 
 	expectedConfigStore := state{
@@ -287,6 +302,7 @@ func TestHandleExecuteEscrow(t *testing.T) {
 		CodeID:    1,
 		InitMsg:   initMsgBz,
 		InitCoins: deposit,
+		Label:     "demo contract",
 	}
 	res = h(data.ctx, initCmd)
 	require.True(t, res.IsOK())
@@ -308,7 +324,7 @@ func TestHandleExecuteEscrow(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz)
+	expectedContractInfo := types.NewContractInfo(1, 1, contractAddr, creator, nil, "demo contract", initMsgBz)
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	handleMsg := map[string]interface{}{
@@ -339,3 +355,18 @@ func TestHandleExecuteEscrow(t *testing.T) {
 	require.NotNil(t, contractAcct)
 	assert.Equal(t, sdk.Coins(nil), contractAcct.GetCoins())
 }
+
+// requireContractEvent returns the contract address of the single from_contract event
+func requireContractEvent(t *testing.T, events sdk.Events) string {
+	var contractEvents []sdk.Event
+	for _, event := range events {
+		if event.Type == types.EventTypeFromContract {
+			contractEvents = append(contractEvents, event)
+		}
+	}
+
+	require.Len(t, contractEvents, 1)
+	attr := contractEvents[0].Attributes[0]
+	require.Equal(t, types.AttributeKeyContractAddress, string(attr.GetKey()))
+	return string(attr.GetValue())
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// authorizationPolicy decides who may upload code, instantiate it and modify contracts
+type authorizationPolicy interface {
+	CanUpload(uploadAccess types.AccessConfig, actor sdk.AccAddress) bool
+	CanInstantiate(instantiatePermission types.AccessConfig, actor sdk.AccAddress) bool
+	CanModifyContract(admin sdk.AccAddress, actor sdk.AccAddress) bool
+}
+
+// defaultAuthorizationPolicy applies the access configs and contract admins to txs
+type defaultAuthorizationPolicy struct{}
+
+func (defaultAuthorizationPolicy) CanUpload(uploadAccess types.AccessConfig, actor sdk.AccAddress) bool {
+	return uploadAccess.Allowed(actor)
+}
+
+func (defaultAuthorizationPolicy) CanInstantiate(instantiatePermission types.AccessConfig, actor sdk.AccAddress) bool {
+	return instantiatePermission.Allowed(actor)
+}
+
+func (defaultAuthorizationPolicy) CanModifyContract(admin sdk.AccAddress, actor sdk.AccAddress) bool {
+	return !admin.Empty() && admin.Equals(actor)
+}
+
+// govAuthorizationPolicy lets passed governance proposals act on any code and contract
+type govAuthorizationPolicy struct{}
+
+func (govAuthorizationPolicy) CanUpload(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (govAuthorizationPolicy) CanInstantiate(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (govAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+// WithGovAuthorization returns a copy of the keeper for passed governance proposals, which
+// bypasses the upload access, the instantiate permissions and the contract admins. Msgs
+// dispatched by contracts still go through the router and the default policy.
+func (k Keeper) WithGovAuthorization() Keeper {
+	k.authZPolicy = govAuthorizationPolicy{}
+	return k
+}
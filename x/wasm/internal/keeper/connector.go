@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 
 	wasmTypes "github.com/confio/go-cosmwasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
 func (k Keeper) dispatchMessages(ctx sdk.Context, contract exported.Account, msgs []wasmTypes.CosmosMsg) sdk.Error {
@@ -18,6 +21,74 @@ func (k Keeper) dispatchMessages(ctx sdk.Context, contract exported.Account, msg
 	return nil
 }
 
+func (k Keeper) dispatchMessage(ctx sdk.Context, contract exported.Account, msg wasmTypes.CosmosMsg) sdk.Error {
+	ctx.GasMeter().ConsumeGas(k.DispatchCost(ctx), "wasm dispatch")
+
+	subMsg, err := decodeSubMsg(msg)
+	if err != nil {
+		return err
+	}
+
+	if subMsg == nil {
+		_, err = k.runMessage(ctx, contract, msg)
+		return err
+	}
+
+	return k.dispatchSubMsg(ctx, contract, *subMsg)
+}
+
+// dispatchSubMsg runs the sub msg and replies its result to the contract when asked to.
+// A failure the contract does not handle fails the contract call.
+func (k Keeper) dispatchSubMsg(ctx sdk.Context, contract exported.Account, subMsg types.SubMsg) sdk.Error {
+	if err := subMsg.ValidateReplyOn(); err != nil {
+		return err
+	}
+
+	var result types.SubMsgResult
+	res, err := k.runMessage(ctx, contract, subMsg.Msg)
+	if err != nil {
+		if !subMsg.ReplyOnError() {
+			return err
+		}
+
+		result.Err = err.Error()
+	} else {
+		if !subMsg.ReplyOnSuccess() {
+			return nil
+		}
+
+		result.Ok = &types.SubMsgExecutionResponse{
+			Events: sdk.StringifyEvents(res.Events.ToABCIEvents()),
+			Data:   res.Data,
+		}
+	}
+
+	bz, stderr := json.Marshal(types.ReplyMsg{Reply: types.Reply{ID: subMsg.ID, Result: result}})
+	if stderr != nil {
+		return sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal reply to JSON", stderr.Error()))
+	}
+
+	_, err = k.execute(ctx, contract.GetAddress(), contract.GetAddress(), nil, bz)
+	return err
+}
+
+// runMessage runs the msg in a cache context, which is only written when the msg succeeds,
+// so a failed msg leaves no state changes behind. Gas is charged either way.
+func (k Keeper) runMessage(ctx sdk.Context, contract exported.Account, msg wasmTypes.CosmosMsg) (sdk.Result, sdk.Error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	data, err := k.handleMessage(cacheCtx, contract, msg)
+	if err != nil {
+		return sdk.Result{}, err
+	}
+
+	writeCache()
+
+	events := cacheCtx.EventManager().Events()
+	ctx.EventManager().EmitEvents(events)
+	return sdk.Result{Data: data, Events: events}, nil
+}
+
 func sendMsgIsEmpty(msg wasmTypes.SendMsg) bool {
 	return msg.FromAddress == "" && msg.ToAddress == "" && len(msg.Amount) == 0
 }
@@ -30,20 +101,69 @@ func isEmpty(msg msgWrapper) bool {
 		return len(m.FromAddress) == 0 && len(m.ToAddress) == 0 && len(m.Amount) == 0
 	case wasmTypes.ContractMsg:
 		return len(m.ContractAddr) == 0 && len(m.Msg) == 0 && len(m.Send) == 0
+	case wasmTypes.OpaqueMsg:
+		return len(m.Data) == 0
 	}
 	return true
 }
 
-func (k Keeper) dispatchMessage(ctx sdk.Context, contract exported.Account, msg wasmTypes.CosmosMsg) sdk.Error {
-	if !isEmpty(msg.Send) && !isEmpty(msg.Contract) {
+func validateCosmosMsg(msg wasmTypes.CosmosMsg) sdk.Error {
+	setMsgs := 0
+	for _, m := range []msgWrapper{msg.Send, msg.Contract, msg.Opaque} {
+		if !isEmpty(m) {
+			setMsgs++
+		}
+	}
+	if setMsgs > 1 {
 		return sdk.ErrInternal("single msg cannot contain multiple msgs")
 	}
+	return nil
+}
+
+// decodeSubMsg returns the sub msg the opaque msg wraps, or nil for any other msg
+func decodeSubMsg(msg wasmTypes.CosmosMsg) (*types.SubMsg, sdk.Error) {
+	if err := validateCosmosMsg(msg); err != nil {
+		return nil, err
+	}
+
+	if isEmpty(msg.Opaque) {
+		return nil, nil
+	}
+
+	terraMsg, err := decodeTerraMsg(msg.Opaque.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	subMsg := terraMsg.SubMsg
+	if subMsg == nil {
+		return nil, nil
+	}
+
+	terraMsg.SubMsg = nil
+	if terraMsg != (types.TerraMsg{}) {
+		return nil, sdk.ErrUnknownRequest("terra msg cannot contain a sub msg along with other msgs")
+	}
+
+	return subMsg, nil
+}
+
+func (k Keeper) handleMessage(ctx sdk.Context, contract exported.Account, msg wasmTypes.CosmosMsg) ([]byte, sdk.Error) {
+	if err := validateCosmosMsg(msg); err != nil {
+		return nil, err
+	}
 
 	// Handle MsgSend
 	if !isEmpty(msg.Send) {
 		sendMsg, err := parseToMsgSend(msg.Send)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		// contract transfers bypass the ante handler, so the tax is collected here
+		err = k.payTax(ctx, contract.GetAddress(), sendMsg.ToAddress, sendMsg.Amount)
+		if err != nil {
+			return nil, err
 		}
 
 		return k.handleSdkMessage(ctx, contract, sendMsg)
@@ -53,25 +173,33 @@ func (k Keeper) dispatchMessage(ctx sdk.Context, contract exported.Account, msg
 	if !isEmpty(msg.Contract) {
 		targetAddr, stderr := sdk.AccAddressFromBech32(msg.Contract.ContractAddr)
 		if stderr != nil {
-			return sdk.ErrInvalidAddress(msg.Contract.ContractAddr)
+			return nil, sdk.ErrInvalidAddress(msg.Contract.ContractAddr)
 		}
 
 		coins, err := parseToCoins(msg.Contract.Send)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		err = k.ExecuteContract(ctx, targetAddr, contract.GetAddress(), coins, []byte(msg.Contract.Msg))
+		err = k.payTax(ctx, contract.GetAddress(), targetAddr, coins)
 		if err != nil {
-			return err
+			return nil, err
 		}
+
+		return k.ExecuteContract(ctx, targetAddr, contract.GetAddress(), coins, []byte(msg.Contract.Msg))
 	}
 
-	if msg.Opaque.Data != "" {
-		return sdk.ErrInternal("dispatch opaque message not yet implemented")
+	// Handle Terra specific msgs
+	if !isEmpty(msg.Opaque) {
+		terraMsg, err := EncodeTerraMsg(contract.GetAddress(), msg.Opaque.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		return k.handleSdkMessage(ctx, contract, terraMsg)
 	}
 
-	return sdk.ErrInternal(fmt.Sprintf("Unknown Msg: %#v", msg))
+	return nil, sdk.ErrInternal(fmt.Sprintf("Unknown Msg: %#v", msg))
 }
 
 func parseToCoins(wasmCoins []wasmTypes.Coin) (coins sdk.Coins, err sdk.Error) {
@@ -116,23 +244,30 @@ func parseToMsgSend(wasmMsg wasmTypes.SendMsg) (msgSend bank.MsgSend, err sdk.Er
 	return
 }
 
-func (k Keeper) handleSdkMessage(ctx sdk.Context, contract exported.Account, msg sdk.Msg) sdk.Error {
+func (k Keeper) handleSdkMessage(ctx sdk.Context, contract exported.Account, msg sdk.Msg) ([]byte, sdk.Error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
 	// make sure this account can send it
 	contractAddr := contract.GetAddress()
 	for _, acct := range msg.GetSigners() {
 		if !acct.Equals(contractAddr) {
-			return sdk.ErrUnauthorized("contract doesn't have permission")
+			return nil, sdk.ErrUnauthorized("contract doesn't have permission")
 		}
 	}
 
 	// find the handler and execute it
 	h := k.router.Route(msg.Route())
 	if h == nil {
-		return sdk.ErrUnknownRequest(msg.Route())
+		return nil, sdk.ErrUnknownRequest(msg.Route())
 	}
 	res := h(ctx, msg)
 	if !res.IsOK() {
-		return sdk.NewError(res.Codespace, res.Code, res.Log)
+		return nil, sdk.NewError(res.Codespace, res.Code, res.Log)
 	}
-	return nil
+
+	// the handlers return their events instead of emitting them to the context
+	ctx.EventManager().EmitEvents(res.Events)
+	return res.Data, nil
 }
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/distribution"
+	"github.com/terra-project/core/x/gov"
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/staking"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func subMsg(t *testing.T, id uint64, msg wasmTypes.CosmosMsg, replyOn string) wasmTypes.CosmosMsg {
+	return wasmTypes.CosmosMsg{
+		Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, types.TerraMsg{
+			SubMsg: &types.SubMsg{ID: id, Msg: msg, ReplyOn: replyOn},
+		})},
+	}
+}
+
+func TestDispatchSubMsg(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit.Add(deposit).Add(deposit))
+	_, _, fred := keyPubAddr()
+	_, _, bob := keyPubAddr()
+	_, _, carl := keyPubAddr()
+
+	// the staking route moves funds before failing, which must be reverted
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bank.NewHandler(keeper.bankKeeper))
+	router.AddRoute(staking.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		delegate := msg.(staking.MsgDelegate)
+		if err := keeper.bankKeeper.SendCoins(ctx, delegate.DelegatorAddress, fred, sdk.NewCoins(delegate.Amount)); err != nil {
+			return err.Result()
+		}
+		return sdk.ErrInternal("delegation failed").Result()
+	})
+	keeper.router = router
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	addr, sdkErr := keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, deposit)
+	require.NoError(t, sdkErr)
+
+	// a second contract released by the first one to carl
+	initMsgBz, err = json.Marshal(InitMsg{Verifier: addr, Beneficiary: carl})
+	require.NoError(t, err)
+	released, sdkErr := keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, deposit)
+	require.NoError(t, sdkErr)
+
+	// a third contract which the first one cannot release
+	initMsgBz, err = json.Marshal(InitMsg{Verifier: fred, Beneficiary: carl})
+	require.NoError(t, err)
+	locked, sdkErr := keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, deposit)
+	require.NoError(t, sdkErr)
+
+	contract := accKeeper.GetAccount(ctx, addr)
+	luna := func(amount string) []wasmTypes.Coin {
+		return []wasmTypes.Coin{{Denom: core.MicroLunaDenom, Amount: amount}}
+	}
+	send := wasmTypes.CosmosMsg{Send: wasmTypes.SendMsg{FromAddress: addr.String(), ToAddress: fred.String(), Amount: luna("1000")}}
+	_, _, valAcc := keyPubAddr()
+	failing := wasmTypes.CosmosMsg{Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, types.TerraMsg{Delegate: &types.DelegateMsg{
+		Validator: sdk.ValAddress(valAcc).String(),
+		Amount:    wasmTypes.Coin{Denom: core.MicroLunaDenom, Amount: "500"},
+	}})}}
+
+	// the failed msg reverts its own state changes only and fails the call
+	sdkErr = keeper.dispatchMessages(ctx, contract, []wasmTypes.CosmosMsg{send, subMsg(t, 1, failing, types.ReplyNever)})
+	require.Error(t, sdkErr)
+	require.Contains(t, sdkErr.Error(), "delegation failed")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)), accKeeper.GetAccount(ctx, fred).GetCoins())
+
+	// a sub msg cannot wrap another sub msg
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, subMsg(t, 2, send, types.ReplyNever), types.ReplyNever))
+	require.Error(t, sdkErr)
+
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, send, "sometimes"))
+	require.Error(t, sdkErr)
+
+	// nested contract calls run in the cache context of the sub msg
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, wasmTypes.CosmosMsg{
+		Contract: wasmTypes.ContractMsg{ContractAddr: released.String(), Msg: "{}"},
+	}, types.ReplyNever))
+	require.NoError(t, sdkErr)
+	require.Equal(t, deposit, accKeeper.GetAccount(ctx, carl).GetCoins())
+
+	// the nested contract's log event reaches the caller's event manager
+	var logged []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFromContract {
+			logged = append(logged, string(event.Attributes[0].GetValue()))
+		}
+	}
+	require.Contains(t, logged, released.String())
+
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, wasmTypes.CosmosMsg{
+		Contract: wasmTypes.ContractMsg{ContractAddr: locked.String(), Msg: "{}", Send: luna("100")},
+	}, types.ReplyNever))
+	require.Error(t, sdkErr)
+	require.Equal(t, deposit, accKeeper.GetAccount(ctx, locked).GetCoins())
+	require.Equal(t, deposit, accKeeper.GetAccount(ctx, carl).GetCoins())
+
+	balance := accKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 99000)), balance)
+
+	// successful sub msgs are not replied unless asked for
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, send, types.ReplyError))
+	require.NoError(t, sdkErr)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000)), accKeeper.GetAccount(ctx, fred).GetCoins())
+}
+
+func TestDispatchSubMsgReply(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit.Add(deposit))
+	_, _, fred := keyPubAddr()
+
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bank.NewHandler(keeper.bankKeeper))
+	router.AddRoute(staking.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.ErrInternal("delegation failed").Result()
+	})
+	keeper.router = router
+
+	// the echo contract stores the last msg it handled under the key "msg"
+	echoCode, err := ioutil.ReadFile("./testdata/echo.wasm")
+	require.NoError(t, err)
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, echoCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	addr, sdkErr := keeper.InstantiateContract(ctx, creator, nil, codeID, "echo contract", []byte("{}"), deposit)
+	require.NoError(t, sdkErr)
+
+	contract := accKeeper.GetAccount(ctx, addr)
+	lastReply := func() types.Reply {
+		var replyMsg types.ReplyMsg
+		require.NoError(t, json.Unmarshal(keeper.queryToStore(ctx, addr, []byte("msg")), &replyMsg))
+		return replyMsg.Reply
+	}
+
+	send := wasmTypes.CosmosMsg{Send: wasmTypes.SendMsg{FromAddress: addr.String(), ToAddress: fred.String(),
+		Amount: []wasmTypes.Coin{{Denom: core.MicroLunaDenom, Amount: "1000"}}}}
+	_, _, valAcc := keyPubAddr()
+	failing := wasmTypes.CosmosMsg{Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, types.TerraMsg{Delegate: &types.DelegateMsg{
+		Validator: sdk.ValAddress(valAcc).String(),
+		Amount:    wasmTypes.Coin{Denom: core.MicroLunaDenom, Amount: "500"},
+	}})}}
+
+	// the result of a successful sub msg carries the events of its handler
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, send, types.ReplySuccess))
+	require.NoError(t, sdkErr)
+
+	reply := lastReply()
+	require.Equal(t, uint64(1), reply.ID)
+	require.Empty(t, reply.Result.Err)
+	require.NotNil(t, reply.Result.Ok)
+
+	var transferred bool
+	for _, event := range reply.Result.Ok.Events {
+		if event.Type == bank.EventTypeTransfer {
+			require.Equal(t, fred.String(), event.Attributes[0].Value)
+			transferred = true
+		}
+	}
+	require.True(t, transferred)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)), accKeeper.GetAccount(ctx, fred).GetCoins())
+
+	// a handled failure is replied to the contract; the gas of the failed msg is still charged
+	gasBefore := ctx.GasMeter().GasConsumed()
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 2, failing, types.ReplyError))
+	require.NoError(t, sdkErr)
+	require.True(t, ctx.GasMeter().GasConsumed() > gasBefore)
+
+	reply = lastReply()
+	require.Equal(t, uint64(2), reply.ID)
+	require.Nil(t, reply.Result.Ok)
+	require.Contains(t, reply.Result.Err, "delegation failed")
+
+	// neither users nor contracts can send a reply
+	spoofed, err := json.Marshal(types.ReplyMsg{Reply: types.Reply{ID: 3, Result: types.SubMsgResult{Err: "spoofed"}}})
+	require.NoError(t, err)
+
+	_, sdkErr = keeper.ExecuteContract(ctx, addr, creator, nil, spoofed)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUnauthorized, sdkErr.Code())
+
+	sdkErr = keeper.dispatchMessage(ctx, contract, wasmTypes.CosmosMsg{
+		Contract: wasmTypes.ContractMsg{ContractAddr: addr.String(), Msg: string(spoofed)},
+	})
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUnauthorized, sdkErr.Code())
+	require.Equal(t, uint64(2), lastReply().ID)
+}
+
+func TestDispatchRevertedContractSubMsgGas(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, fred := keyPubAddr()
+
+	// the swap of the callee funds fred, but its vote fails after that
+	router := baseapp.NewRouter()
+	router.AddRoute(market.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		fredAcct := accKeeper.NewAccountWithAddress(ctx, fred)
+		_ = fredAcct.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000)))
+		accKeeper.SetAccount(ctx, fredAcct)
+		return sdk.Result{}
+	})
+	router.AddRoute(staking.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	router.AddRoute(distribution.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	router.AddRoute(gov.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.ErrInternal("vote failed").Result()
+	})
+	keeper.router = router
+
+	echoCode, err := ioutil.ReadFile("./testdata/echo.wasm")
+	require.NoError(t, err)
+	echoCodeID, sdkErr := keeper.StoreCode(ctx, creator, echoCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	echoAddr, sdkErr := keeper.InstantiateContract(ctx, creator, nil, echoCodeID, "echo contract", []byte("{}"), nil)
+	require.NoError(t, sdkErr)
+
+	terraMsgsCode, err := ioutil.ReadFile("./testdata/terra_msgs.wasm")
+	require.NoError(t, err)
+	terraMsgsCodeID, sdkErr := keeper.StoreCode(ctx, creator, terraMsgsCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	terraMsgsAddr, sdkErr := keeper.InstantiateContract(ctx, creator, nil, terraMsgsCodeID, "terra msgs contract", []byte("{}"), nil)
+	require.NoError(t, sdkErr)
+
+	contract := accKeeper.GetAccount(ctx, echoAddr)
+	call := wasmTypes.CosmosMsg{Contract: wasmTypes.ContractMsg{ContractAddr: terraMsgsAddr.String(), Msg: "{}"}}
+
+	// the reverted call is charged with the gas of the callee, its msgs and the reply
+	gasBefore := ctx.GasMeter().GasConsumed()
+	sdkErr = keeper.dispatchMessage(ctx, contract, subMsg(t, 1, call, types.ReplyError))
+	require.NoError(t, sdkErr)
+	require.Equal(t, uint64(68340), ctx.GasMeter().GasConsumed()-gasBefore)
+
+	require.Nil(t, accKeeper.GetAccount(ctx, fred))
+
+	var replyMsg types.ReplyMsg
+	require.NoError(t, json.Unmarshal(keeper.queryToStore(ctx, echoAddr, []byte("msg")), &replyMsg))
+	require.Equal(t, uint64(1), replyMsg.Reply.ID)
+	require.Contains(t, replyMsg.Reply.Result.Err, "vote failed")
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/tendermint/tendermint/crypto"
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
@@ -33,42 +36,108 @@ func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddre
 	return
 }
 
-// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code
-func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte) (codeID uint64, sdkErr sdk.Error) {
+// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code.
+// The creator must be permitted by the upload access param.
+func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiatePermission types.AccessConfig) (codeID uint64, sdkErr sdk.Error) {
+	if !k.authZPolicy.CanUpload(k.UploadAccess(ctx), creator) {
+		return 0, types.ErrUploadDenied(creator)
+	}
+
 	wasmCode, err := k.uncompress(ctx, wasmCode)
 	if err != nil {
 		return 0, types.ErrCreateFailed(err)
 	}
 
-	codeHash, err := k.wasmer.Create(wasmCode)
+	if maxSize := k.MaxContractSize(ctx); int64(len(wasmCode)) > maxSize {
+		return 0, types.ErrCreateFailed(fmt.Errorf("wasm code of %d bytes exceeds the max contract size %d", len(wasmCode), maxSize))
+	}
+
+	ctx.GasMeter().ConsumeGas(mulUint64(uint64(len(wasmCode)), k.CompileCostPerByte(ctx)), "wasm compile")
+
+	codeHash, err := k.createCode(wasmCode)
 	if err != nil {
 		return 0, types.ErrCreateFailed(err)
 	}
 
 	codeID = k.increaseLastCodeID(ctx)
-	contractInfo := types.NewCodeInfo(codeHash, creator)
-	k.SetCodeInfo(ctx, codeID, contractInfo)
+	codeInfo := types.NewCodeInfo(codeHash, creator, instantiatePermission)
+	k.SetCodeInfo(ctx, codeID, codeInfo)
 
 	return codeID, nil
 }
 
-// InstantiateContract creates an instance of a WASM contract
-func (k Keeper) InstantiateContract(ctx sdk.Context, creator sdk.AccAddress, codeID uint64, initMsg []byte, deposit sdk.Coins) (contractAddress sdk.AccAddress, err sdk.Error) {
-	// create contract address
-	contractAddress = k.generateContractAddress(ctx, codeID)
-	existingAccnt := k.accountKeeper.GetAccount(ctx, contractAddress)
-	if existingAccnt != nil {
-		err = types.ErrAccountExists(existingAccnt.GetAddress())
-		return
+// createCode compiles the bytecode into the wasmer and returns its code hash. The wasmer
+// refuses to write the same bytecode twice, which happens when identical code is stored
+// again or the wasm dir outlives the chain state, so existing bytecode is reused.
+func (k Keeper) createCode(wasmCode []byte) ([]byte, error) {
+	codeHash, err := k.wasmer.Create(wasmCode)
+	if err != nil {
+		hash := sha256.Sum256(wasmCode)
+		stored, getErr := k.wasmer.GetCode(hash[:])
+		if getErr != nil || !bytes.Equal(stored, wasmCode) {
+			return nil, err
+		}
+		codeHash = hash[:]
 	}
+	return codeHash, nil
+}
 
-	// deposit initial contract funds
-	err = k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit)
+// ImportCode stores the bytecode under the given code ID without checking the upload access
+// and without touching the code ID sequence; it is meant for genesis import only
+func (k Keeper) ImportCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) sdk.Error {
+	if _, sdkErr := k.GetCodeInfo(ctx, codeID); sdkErr == nil {
+		return types.ErrCreateFailed(fmt.Errorf("code %d already exists", codeID))
+	}
+
+	wasmCode, err := k.uncompress(ctx, wasmCode)
 	if err != nil {
-		return
+		return types.ErrCreateFailed(err)
 	}
 
-	contractAccount := k.accountKeeper.GetAccount(ctx, contractAddress)
+	codeHash, err := k.createCode(wasmCode)
+	if err != nil {
+		return types.ErrCreateFailed(err)
+	}
+
+	if !bytes.Equal(codeInfo.CodeHash, codeHash) {
+		return types.ErrCreateFailed(fmt.Errorf("code hash of code %d does not match its bytecode", codeID))
+	}
+
+	k.SetCodeInfo(ctx, codeID, codeInfo)
+	return nil
+}
+
+// InstantiateContract creates an instance of a WASM contract at an address taken from the instance
+// counter; the admin is optional
+func (k Keeper) InstantiateContract(ctx sdk.Context, creator sdk.AccAddress, admin sdk.AccAddress, codeID uint64, label string, initMsg []byte, deposit sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	return k.instantiate(ctx, creator, admin, codeID, label, initMsg, deposit, func(types.CodeInfo) (sdk.AccAddress, uint64, sdk.Error) {
+		contractAddress, instanceID := k.generateContractAddress(ctx, codeID)
+		if existingAccnt := k.accountKeeper.GetAccount(ctx, contractAddress); existingAccnt != nil {
+			return nil, 0, types.ErrAccountExists(existingAccnt.GetAddress())
+		}
+		return contractAddress, instanceID, nil
+	})
+}
+
+// InstantiateContractWithSalt creates an instance of a WASM contract at the address derived from the
+// creator, the code hash and the salt, which is known before the tx lands. Funds sent to the address
+// beforehand are kept, but the address must not hold a contract or an account with a public key.
+func (k Keeper) InstantiateContractWithSalt(ctx sdk.Context, creator sdk.AccAddress, admin sdk.AccAddress, codeID uint64, label string, salt []byte, initMsg []byte, deposit sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	return k.instantiate(ctx, creator, admin, codeID, label, initMsg, deposit, func(codeInfo types.CodeInfo) (sdk.AccAddress, uint64, sdk.Error) {
+		contractAddress := types.PredictableContractAddress(creator, codeInfo.CodeHash, salt)
+		if ctx.KVStore(k.storeKey).Has(types.GetContractInfoKey(contractAddress)) {
+			return nil, 0, types.ErrAccountExists(contractAddress)
+		}
+		if existingAccnt := k.accountKeeper.GetAccount(ctx, contractAddress); existingAccnt != nil && existingAccnt.GetPubKey() != nil {
+			return nil, 0, types.ErrAccountExists(contractAddress)
+		}
+		return contractAddress, 0, nil
+	})
+}
+
+func (k Keeper) instantiate(ctx sdk.Context, creator sdk.AccAddress, admin sdk.AccAddress, codeID uint64, label string, initMsg []byte, deposit sdk.Coins,
+	addressGenerator func(types.CodeInfo) (sdk.AccAddress, uint64, sdk.Error)) (contractAddress sdk.AccAddress, err sdk.Error) {
+	ctx.GasMeter().ConsumeGas(k.InstantiateCost(ctx), "wasm instantiate")
 
 	// get code info
 	store := ctx.KVStore(k.storeKey)
@@ -80,22 +149,45 @@ func (k Keeper) InstantiateContract(ctx sdk.Context, creator sdk.AccAddress, cod
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
 
+	if !k.authZPolicy.CanInstantiate(codeInfo.InstantiatePermission, creator) {
+		err = types.ErrInstantiateDenied(creator, codeID)
+		return
+	}
+
+	// create contract address
+	contractAddress, instanceID, err := addressGenerator(codeInfo)
+	if err != nil {
+		return
+	}
+
+	// deposit initial contract funds
+	err = k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit)
+	if err != nil {
+		return
+	}
+
+	contractAccount := k.accountKeeper.GetAccount(ctx, contractAddress)
+
 	// prepare params for contract instantiate call
 	params := types.NewWasmAPIParams(ctx, creator, deposit, contractAccount)
 
 	// create prefixed data store
 	contractStoreKey := types.GetContractStoreKey(contractAddress)
-	contractStore := prefix.NewStore(ctx.KVStore(k.storeKey), contractStoreKey)
+	gas := k.gasForContract(ctx)
+	contractStore := k.newContractStore(ctx, prefix.NewStore(ctx.KVStore(k.storeKey), contractStoreKey), gas)
 
 	// instantiate wasm contract
-	gas := k.gasForContract(ctx)
 	res, err2 := k.wasmer.Instantiate(codeInfo.CodeHash, params, initMsg, contractStore, cosmwasmAPI, gas)
+	if err = k.consumeStoreGas(ctx, contractStore); err != nil {
+		return
+	}
 	if err2 != nil {
 		err = types.ErrInstantiateFailed(err2)
 		return
 	}
 
 	k.consumeGas(ctx, res.GasUsed)
+	ctx.EventManager().EmitEvent(types.NewContractLogEvent(contractAddress, res.Log))
 
 	err = k.dispatchMessages(ctx, contractAccount, res.Messages)
 	if err != nil {
@@ -103,40 +195,85 @@ func (k Keeper) InstantiateContract(ctx sdk.Context, creator sdk.AccAddress, cod
 	}
 
 	// persist contractInfo
-	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, initMsg)
+	contractInfo := types.NewContractInfo(codeID, instanceID, contractAddress, creator, admin, label, initMsg)
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
 
 	return contractAddress, nil
 }
 
-// ExecuteContract executes the contract instance
-func (k Keeper) ExecuteContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, coins sdk.Coins, msg []byte) sdk.Error {
+// ExecuteContract executes the contract and returns the data it responded with.
+// A ReplyMsg is rejected, as only the sub msg results are sent back with it.
+func (k Keeper) ExecuteContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, coins sdk.Coins, msg []byte) ([]byte, sdk.Error) {
+	if types.IsReplyMsg(msg) {
+		return nil, types.ErrUnauthorized("reply msg is reserved for sub msg results")
+	}
+
+	return k.execute(ctx, contractAddress, caller, coins, msg)
+}
+
+func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, coins sdk.Coins, msg []byte) ([]byte, sdk.Error) {
 	codeInfo, storePrefix, sdkerr := k.getContractDetails(ctx, contractAddress)
 	if sdkerr != nil {
-		return sdkerr
+		return nil, sdkerr
 	}
 
 	// add more funds
 	sdkerr = k.bankKeeper.SendCoins(ctx, caller, contractAddress, coins)
 	if sdkerr != nil {
-		return sdkerr
+		return nil, sdkerr
 	}
 	contractAccount := k.accountKeeper.GetAccount(ctx, contractAddress)
 	params := types.NewWasmAPIParams(ctx, caller, coins, contractAccount)
 
 	gas := k.gasForContract(ctx)
-	res, err := k.wasmer.Execute(codeInfo.CodeHash, params, msg, storePrefix, cosmwasmAPI, gas)
+	contractStore := k.newContractStore(ctx, storePrefix, gas)
+	res, err := k.wasmer.Execute(codeInfo.CodeHash, params, msg, contractStore, cosmwasmAPI, gas)
+	if sdkerr = k.consumeStoreGas(ctx, contractStore); sdkerr != nil {
+		return nil, sdkerr
+	}
 	if err != nil {
-		return types.ErrExecuteFailed(err)
+		return nil, types.ErrExecuteFailed(err)
 	}
 
 	k.consumeGas(ctx, res.GasUsed)
+	ctx.EventManager().EmitEvent(types.NewContractLogEvent(contractAddress, res.Log))
+
+	data, err := base64.StdEncoding.DecodeString(res.Data)
+	if err != nil {
+		return nil, types.ErrExecuteFailed(err)
+	}
 
 	sdkerr = k.dispatchMessages(ctx, contractAccount, res.Messages)
 	if sdkerr != nil {
-		return sdkerr
+		return nil, sdkerr
+	}
+
+	return data, nil
+}
+
+// UpdateContractAdmin hands over the admin of the contract instance to newAdmin
+func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) sdk.Error {
+	return k.setContractAdmin(ctx, contractAddress, caller, newAdmin)
+}
+
+// ClearContractAdmin removes the admin of the contract instance for good
+func (k Keeper) ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) sdk.Error {
+	return k.setContractAdmin(ctx, contractAddress, caller, nil)
+}
+
+func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) sdk.Error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if !k.authZPolicy.CanModifyContract(contractInfo.Admin, caller) {
+		return types.ErrUnauthorized("only the contract admin can change the admin")
 	}
 
+	contractInfo.Admin = newAdmin
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+
 	return nil
 }
 
@@ -157,12 +294,9 @@ func (k Keeper) consumeGas(ctx sdk.Context, gas uint64) {
 
 // generates a contract address from codeID + instanceID
 // and increases last instanceID
-func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64) sdk.AccAddress {
+func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64) (sdk.AccAddress, uint64) {
 	instanceID := k.increaseLastInstanceID(ctx)
-	// NOTE: It is possible to get a duplicate address if either codeID or instanceID
-	// overflow 32 bits. This is highly improbable, but something that could be refactored.
-	contractID := codeID<<32 + instanceID
-	return addrFromUint64(contractID)
+	return types.ContractAddress(codeID, instanceID), instanceID
 }
 
 // GetNextCodeID returns next code ID which is sequentially increasing
@@ -176,6 +310,29 @@ func (k Keeper) GetNextCodeID(ctx sdk.Context) uint64 {
 	return id
 }
 
+// SetNextCodeID sets the code ID the next stored code receives
+func (k Keeper) SetNextCodeID(ctx sdk.Context, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastCodeIDKey, sdk.Uint64ToBigEndian(codeID))
+}
+
+// GetNextInstanceID returns next instance ID which is sequentially increasing
+func (k Keeper) GetNextInstanceID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastInstanceIDKey)
+	id := uint64(1)
+	if bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	return id
+}
+
+// SetNextInstanceID sets the instance ID the next counter addressed contract receives
+func (k Keeper) SetNextInstanceID(ctx sdk.Context, instanceID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastInstanceIDKey, sdk.Uint64ToBigEndian(instanceID))
+}
+
 func (k Keeper) increaseLastCodeID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.LastCodeIDKey)
@@ -200,13 +357,6 @@ func (k Keeper) increaseLastInstanceID(ctx sdk.Context) uint64 {
 	return id
 }
 
-func addrFromUint64(id uint64) sdk.AccAddress {
-	addr := make([]byte, 20)
-	addr[0] = 'C'
-	binary.PutUvarint(addr[1:], id)
-	return sdk.AccAddress(crypto.AddressHash(addr))
-}
-
 func (k Keeper) queryToStore(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) (result []byte) {
 	if key == nil {
 		return result
@@ -227,7 +377,12 @@ func (k Keeper) queryToContract(ctx sdk.Context, contractAddr sdk.AccAddress, ke
 	if err != nil {
 		return nil, err
 	}
-	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, key, contractStorePrefix, cosmwasmAPI, k.gasForContract(ctx))
+	gas := k.gasForContract(ctx)
+	contractStore := k.newContractStore(ctx, contractStorePrefix, gas)
+	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, key, contractStore, cosmwasmAPI, gas)
+	if err = k.consumeStoreGas(ctx, contractStore); err != nil {
+		return nil, err
+	}
 	if qErr != nil {
 
 		return nil, sdk.ErrInternal(qErr.Error())
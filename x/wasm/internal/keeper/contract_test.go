@@ -30,7 +30,7 @@ func TestStoreCode(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create contract
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 
@@ -55,7 +55,7 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm.gzip")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 	// and verify content
@@ -66,6 +66,113 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	require.Equal(t, rawCode, storedCode)
 }
 
+func TestStoreCodeTwice(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), contractID)
+
+	// the same bytecode gets a new code id sharing the compiled code
+	contractID, err = keeper.StoreCode(ctx, creator, wasmCode, types.AllowNobody)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), contractID)
+
+	codeInfo1, err := keeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	codeInfo2, err := keeper.GetCodeInfo(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, codeInfo1.CodeHash, codeInfo2.CodeHash)
+	require.Equal(t, types.AllowNobody, codeInfo2.InstantiatePermission)
+
+	storedCode, err := keeper.GetBytecode(ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, wasmCode, storedCode)
+}
+
+func TestStoreCodeUploadAccess(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	uploader := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.UploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	_, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUploadDenied, sdkErr.Code())
+
+	params.UploadAccess = types.NewAllowListAccess(uploader)
+	keeper.SetParams(ctx, params)
+
+	_, sdkErr = keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUploadDenied, sdkErr.Code())
+
+	codeID, sdkErr := keeper.StoreCode(ctx, uploader, wasmCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	require.Equal(t, uint64(1), codeID)
+}
+
+func TestInstantiatePermission(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	other := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.NewAllowListAccess(creator))
+	require.NoError(t, sdkErr)
+
+	codeInfo, sdkErr := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, sdkErr)
+	require.Equal(t, types.NewAllowListAccess(creator), codeInfo.InstantiatePermission)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	_, sdkErr = keeper.InstantiateContract(ctx, other, nil, codeID, "demo contract", initMsgBz, nil)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeInstantiateDenied, sdkErr.Code())
+
+	_, sdkErr = keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, nil)
+	require.NoError(t, sdkErr)
+}
+
 func TestInstantiate(t *testing.T) {
 	// Create & set temp as home
 	tempDir, err := ioutil.TempDir("", "wasmtest")
@@ -80,7 +187,7 @@ func TestInstantiate(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -95,11 +202,72 @@ func TestInstantiate(t *testing.T) {
 	require.NoError(t, err)
 
 	// create with no balance is also legal
-	addr, err := keeper.InstantiateContract(ctx, creator, contractID, initMsgBz, nil)
+	addr, err := keeper.InstantiateContract(ctx, creator, nil, contractID, "demo contract", initMsgBz, nil)
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 }
 
+func TestInstantiateWithSalt(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	codeInfo, sdkErr := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, sdkErr)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	// the address is known before instantiation and the instance counter is untouched
+	expected := types.PredictableContractAddress(creator, codeInfo.CodeHash, []byte("salt"))
+	addr, sdkErr := keeper.InstantiateContractWithSalt(ctx, creator, nil, codeID, "salted", []byte("salt"), initMsgBz, nil)
+	require.NoError(t, sdkErr)
+	require.Equal(t, expected, addr)
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.LastInstanceIDKey))
+
+	contractInfo, sdkErr := keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, sdkErr)
+	require.Equal(t, "salted", contractInfo.Label)
+
+	// the same salt collides, another creator gets another address
+	_, sdkErr = keeper.InstantiateContractWithSalt(ctx, creator, nil, codeID, "salted", []byte("salt"), initMsgBz, nil)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeAccountExists, sdkErr.Code())
+	require.NotEqual(t, expected, types.PredictableContractAddress(bob, codeInfo.CodeHash, []byte("salt")))
+
+	// funds sent to the address beforehand do not block it
+	expected = types.PredictableContractAddress(creator, codeInfo.CodeHash, []byte("funded"))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, creator, expected, topUp))
+	addr, sdkErr = keeper.InstantiateContractWithSalt(ctx, creator, nil, codeID, "funded", []byte("funded"), initMsgBz, topUp)
+	require.NoError(t, sdkErr)
+	require.Equal(t, expected, addr)
+	require.Equal(t, topUp.Add(topUp), accKeeper.GetAccount(ctx, addr).GetCoins())
+
+	// but an account owned by a key does
+	expected = types.PredictableContractAddress(creator, codeInfo.CodeHash, []byte("owned"))
+	_, pubKey, _ := keyPubAddr()
+	account := accKeeper.NewAccountWithAddress(ctx, expected)
+	require.NoError(t, account.SetPubKey(pubKey))
+	accKeeper.SetAccount(ctx, account)
+	_, sdkErr = keeper.InstantiateContractWithSalt(ctx, creator, nil, codeID, "owned", []byte("owned"), initMsgBz, nil)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeAccountExists, sdkErr.Code())
+}
+
 func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	// Create & set temp as home
 	tempDir, err := ioutil.TempDir("", "wasmtest")
@@ -116,7 +284,7 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.NoError(t, err)
 
 	const nonExistingCodeID = 9999
-	_, err = keeper.InstantiateContract(ctx, creator, nonExistingCodeID, initMsgBz, nil)
+	_, err = keeper.InstantiateContract(ctx, creator, nil, nonExistingCodeID, "demo contract", initMsgBz, nil)
 	require.Error(t, err, types.ErrNotFound("contract"))
 }
 
@@ -136,7 +304,7 @@ func TestExecute(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
 	require.NoError(t, err)
 
-	contractID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	contractID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -147,7 +315,7 @@ func TestExecute(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, creator, contractID, initMsgBz, deposit)
+	addr, err := keeper.InstantiateContract(ctx, creator, nil, contractID, "demo contract", initMsgBz, deposit)
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 
@@ -168,11 +336,11 @@ func TestExecute(t *testing.T) {
 
 	// unauthorized - trialCtx so we don't change state
 	trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
-	err = keeper.ExecuteContract(trialCtx, addr, creator, nil, []byte(`{}`))
+	_, err = keeper.ExecuteContract(trialCtx, addr, creator, nil, []byte(`{}`))
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Unauthorized")
 
-	err = keeper.ExecuteContract(ctx, addr, fred, topUp, []byte(`{}`))
+	_, err = keeper.ExecuteContract(ctx, addr, fred, topUp, []byte(`{}`))
 	require.NoError(t, err)
 
 	// ensure bob now exists and got both payments released
@@ -199,7 +367,62 @@ func TestExecuteWithNonExistingContractAddress(t *testing.T) {
 	creator := createFakeFundedAccount(ctx, accKeeper, deposit.Add(deposit))
 
 	// unauthorized - trialCtx so we don't change state
-	nonExistingContractAddress := addrFromUint64(9999)
-	err = keeper.ExecuteContract(ctx, nonExistingContractAddress, creator, nil, []byte(`{}`))
+	nonExistingContractAddress := types.ContractAddress(0, 9999)
+	_, err = keeper.ExecuteContract(ctx, nonExistingContractAddress, creator, nil, []byte(`{}`))
 	require.Error(t, err, types.ErrNotFound("contract info"))
 }
+
+func TestContractAdmin(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	addr, sdkErr := keeper.InstantiateContract(ctx, creator, admin, codeID, "demo contract", initMsgBz, nil)
+	require.NoError(t, sdkErr)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, admin, contractInfo.Admin)
+
+	// only the admin can hand over the admin
+	sdkErr = keeper.UpdateContractAdmin(ctx, addr, creator, creator)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUnauthorized, sdkErr.Code())
+
+	sdkErr = keeper.UpdateContractAdmin(ctx, addr, admin, creator)
+	require.NoError(t, sdkErr)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, creator, contractInfo.Admin)
+
+	// the admin can only be cleared for good
+	sdkErr = keeper.ClearContractAdmin(ctx, addr, creator)
+	require.NoError(t, sdkErr)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, err)
+	require.Empty(t, contractInfo.Admin)
+
+	sdkErr = keeper.UpdateContractAdmin(ctx, addr, creator, admin)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeUnauthorized, sdkErr.Code())
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/distribution"
+	"github.com/terra-project/core/x/gov"
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/staking"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// EncodeTerraMsg converts the base64 encoded JSON TerraMsg of an opaque message
+// to the sdk.Msg it describes, with the contract as the signer
+func EncodeTerraMsg(contractAddr sdk.AccAddress, data string) (sdk.Msg, sdk.Error) {
+	msg, err := decodeTerraMsg(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// sub msgs are unwrapped by the dispatcher and cannot be nested
+	if msg.SubMsg != nil {
+		return nil, sdk.ErrUnknownRequest("sub msg is not an sdk msg")
+	}
+
+	var encoded []sdk.Msg
+	if msg.Swap != nil {
+		offerCoin, err := parseToCoin(msg.Swap.OfferCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, market.NewMsgSwap(contractAddr, offerCoin, msg.Swap.AskDenom))
+	}
+
+	if msg.Delegate != nil {
+		valAddr, amount, err := parseToDelegation(msg.Delegate.Validator, msg.Delegate.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, staking.NewMsgDelegate(contractAddr, valAddr, amount))
+	}
+
+	if msg.Undelegate != nil {
+		valAddr, amount, err := parseToDelegation(msg.Undelegate.Validator, msg.Undelegate.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, staking.NewMsgUndelegate(contractAddr, valAddr, amount))
+	}
+
+	if msg.Redelegate != nil {
+		srcValAddr, amount, err := parseToDelegation(msg.Redelegate.SrcValidator, msg.Redelegate.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		dstValAddr, stderr := sdk.ValAddressFromBech32(msg.Redelegate.DstValidator)
+		if stderr != nil {
+			return nil, sdk.ErrInvalidAddress(msg.Redelegate.DstValidator)
+		}
+
+		encoded = append(encoded, staking.NewMsgBeginRedelegate(contractAddr, srcValAddr, dstValAddr, amount))
+	}
+
+	if msg.WithdrawDelegatorReward != nil {
+		valAddr, stderr := sdk.ValAddressFromBech32(msg.WithdrawDelegatorReward.Validator)
+		if stderr != nil {
+			return nil, sdk.ErrInvalidAddress(msg.WithdrawDelegatorReward.Validator)
+		}
+
+		encoded = append(encoded, distribution.NewMsgWithdrawDelegatorReward(contractAddr, valAddr))
+	}
+
+	if msg.Vote != nil {
+		option, err := parseToVoteOption(msg.Vote.Option)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, gov.NewMsgVote(contractAddr, msg.Vote.ProposalID, option))
+	}
+
+	if len(encoded) != 1 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("terra msg must contain exactly one msg, got %d", len(encoded)))
+	}
+
+	return encoded[0], nil
+}
+
+func decodeTerraMsg(data string) (msg types.TerraMsg, err sdk.Error) {
+	bz, stderr := base64.StdEncoding.DecodeString(data)
+	if stderr != nil {
+		err = sdk.ErrUnknownRequest(fmt.Sprintf("opaque msg is not base64 encoded: %s", stderr))
+		return
+	}
+
+	if stderr := json.Unmarshal(bz, &msg); stderr != nil {
+		err = sdk.ErrUnknownRequest(fmt.Sprintf("opaque msg is not a terra msg: %s", stderr))
+	}
+	return
+}
+
+func parseToCoin(wasmCoin wasmTypes.Coin) (coin sdk.Coin, err sdk.Error) {
+	amount, ok := sdk.NewIntFromString(wasmCoin.Amount)
+	if !ok {
+		err = sdk.ErrInvalidCoins(fmt.Sprintf("Failed to parse %s", wasmCoin.Amount))
+		return
+	}
+
+	coin = sdk.Coin{
+		Denom:  wasmCoin.Denom,
+		Amount: amount,
+	}
+	return
+}
+
+func parseToDelegation(validator string, wasmCoin wasmTypes.Coin) (valAddr sdk.ValAddress, amount sdk.Coin, err sdk.Error) {
+	valAddr, stderr := sdk.ValAddressFromBech32(validator)
+	if stderr != nil {
+		err = sdk.ErrInvalidAddress(validator)
+		return
+	}
+
+	amount, err = parseToCoin(wasmCoin)
+	return
+}
+
+func parseToVoteOption(option string) (gov.VoteOption, sdk.Error) {
+	switch option {
+	case "yes":
+		return gov.OptionYes, nil
+	case "no":
+		return gov.OptionNo, nil
+	case "abstain":
+		return gov.OptionAbstain, nil
+	case "no_with_veto":
+		return gov.OptionNoWithVeto, nil
+	}
+
+	return gov.OptionEmpty, sdk.ErrUnknownRequest(fmt.Sprintf("invalid vote option %q", option))
+}
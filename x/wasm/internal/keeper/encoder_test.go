@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/distribution"
+	"github.com/terra-project/core/x/gov"
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/staking"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func encodeTerraMsg(t *testing.T, msg types.TerraMsg) string {
+	bz, err := json.Marshal(msg)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(bz)
+}
+
+func TestEncodeTerraMsg(t *testing.T) {
+	_, _, contractAddr := keyPubAddr()
+	_, _, valAcc := keyPubAddr()
+	_, _, dstValAcc := keyPubAddr()
+	valAddr := sdk.ValAddress(valAcc)
+	dstValAddr := sdk.ValAddress(dstValAcc)
+
+	luna := wasmTypes.Coin{Denom: core.MicroLunaDenom, Amount: "1000"}
+	lunaCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000)
+
+	cases := map[string]struct {
+		msg      types.TerraMsg
+		expected sdk.Msg
+		isValid  bool
+	}{
+		"swap": {
+			msg:      types.TerraMsg{Swap: &types.SwapMsg{OfferCoin: luna, AskDenom: core.MicroSDRDenom}},
+			expected: market.NewMsgSwap(contractAddr, lunaCoin, core.MicroSDRDenom),
+			isValid:  true,
+		},
+		"delegate": {
+			msg:      types.TerraMsg{Delegate: &types.DelegateMsg{Validator: valAddr.String(), Amount: luna}},
+			expected: staking.NewMsgDelegate(contractAddr, valAddr, lunaCoin),
+			isValid:  true,
+		},
+		"undelegate": {
+			msg:      types.TerraMsg{Undelegate: &types.UndelegateMsg{Validator: valAddr.String(), Amount: luna}},
+			expected: staking.NewMsgUndelegate(contractAddr, valAddr, lunaCoin),
+			isValid:  true,
+		},
+		"redelegate": {
+			msg: types.TerraMsg{Redelegate: &types.RedelegateMsg{
+				SrcValidator: valAddr.String(), DstValidator: dstValAddr.String(), Amount: luna}},
+			expected: staking.NewMsgBeginRedelegate(contractAddr, valAddr, dstValAddr, lunaCoin),
+			isValid:  true,
+		},
+		"withdraw delegator reward": {
+			msg:      types.TerraMsg{WithdrawDelegatorReward: &types.WithdrawDelegatorRewardMsg{Validator: valAddr.String()}},
+			expected: distribution.NewMsgWithdrawDelegatorReward(contractAddr, valAddr),
+			isValid:  true,
+		},
+		"vote": {
+			msg:      types.TerraMsg{Vote: &types.VoteMsg{ProposalID: 1, Option: "no_with_veto"}},
+			expected: gov.NewMsgVote(contractAddr, 1, gov.OptionNoWithVeto),
+			isValid:  true,
+		},
+		"empty": {
+			msg:     types.TerraMsg{},
+			isValid: false,
+		},
+		"multiple msgs": {
+			msg: types.TerraMsg{
+				Vote:                    &types.VoteMsg{ProposalID: 1, Option: "yes"},
+				WithdrawDelegatorReward: &types.WithdrawDelegatorRewardMsg{Validator: valAddr.String()},
+			},
+			isValid: false,
+		},
+		"invalid validator": {
+			msg:     types.TerraMsg{Delegate: &types.DelegateMsg{Validator: contractAddr.String(), Amount: luna}},
+			isValid: false,
+		},
+		"invalid amount": {
+			msg:     types.TerraMsg{Swap: &types.SwapMsg{OfferCoin: wasmTypes.Coin{Denom: core.MicroLunaDenom, Amount: "1.5"}}},
+			isValid: false,
+		},
+		"invalid vote option": {
+			msg:     types.TerraMsg{Vote: &types.VoteMsg{ProposalID: 1, Option: "maybe"}},
+			isValid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := EncodeTerraMsg(contractAddr, encodeTerraMsg(t, tc.msg))
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, msg)
+			require.Equal(t, []sdk.AccAddress{contractAddr}, msg.GetSigners())
+		})
+	}
+
+	_, err := EncodeTerraMsg(contractAddr, "not base64!")
+	require.Error(t, err)
+}
+
+func TestDispatchTerraMsg(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	var handled []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute(staking.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		handled = append(handled, msg)
+		return sdk.Result{}
+	})
+	keeper.router = router
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	contractAddr := createFakeFundedAccount(ctx, accKeeper, funds)
+	contract := accKeeper.GetAccount(ctx, contractAddr)
+	_, _, valAcc := keyPubAddr()
+	valAddr := sdk.ValAddress(valAcc)
+
+	delegate := types.TerraMsg{Delegate: &types.DelegateMsg{
+		Validator: valAddr.String(),
+		Amount:    wasmTypes.Coin{Denom: core.MicroLunaDenom, Amount: "1000"},
+	}}
+	err = keeper.dispatchMessage(ctx, contract, wasmTypes.CosmosMsg{
+		Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, delegate)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{
+		staking.NewMsgDelegate(contractAddr, valAddr, sdk.NewInt64Coin(core.MicroLunaDenom, 1000)),
+	}, handled)
+
+	// msgs failing the basic validation never reach the handler
+	delegate.Delegate.Amount.Amount = "0"
+	err = keeper.dispatchMessage(ctx, contract, wasmTypes.CosmosMsg{
+		Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, delegate)},
+	})
+	require.Error(t, err)
+	require.Len(t, handled, 1)
+
+	// a route the keeper does not know
+	vote := types.TerraMsg{Vote: &types.VoteMsg{ProposalID: 1, Option: "yes"}}
+	err = keeper.dispatchMessage(ctx, contract, wasmTypes.CosmosMsg{
+		Opaque: wasmTypes.OpaqueMsg{Data: encodeTerraMsg(t, vote)},
+	})
+	require.Error(t, err)
+	require.Len(t, handled, 1)
+}
+
+func TestExecuteTerraMsgs(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+
+	var handled []sdk.Msg
+	capture := func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		handled = append(handled, msg)
+		return sdk.Result{}
+	}
+	router := baseapp.NewRouter()
+	for _, route := range []string{market.RouterKey, staking.RouterKey, distribution.RouterKey, gov.RouterKey} {
+		router.AddRoute(route, capture)
+	}
+	keeper.router = router
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	// the terra_msgs contract returns one msg of every kind on each handle
+	terraMsgsCode, err := ioutil.ReadFile("./testdata/terra_msgs.wasm")
+	require.NoError(t, err)
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, terraMsgsCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+	addr, sdkErr := keeper.InstantiateContract(ctx, creator, nil, codeID, "terra msgs contract", []byte("{}"), nil)
+	require.NoError(t, sdkErr)
+	require.Empty(t, handled)
+
+	_, sdkErr = keeper.ExecuteContract(ctx, addr, creator, nil, []byte("{}"))
+	require.NoError(t, sdkErr)
+
+	srcValAddr := sdk.ValAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	dstValAddr := sdk.ValAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	require.Equal(t, []sdk.Msg{
+		market.NewMsgSwap(addr, sdk.NewInt64Coin(core.MicroLunaDenom, 1000), core.MicroSDRDenom),
+		staking.NewMsgDelegate(addr, srcValAddr, sdk.NewInt64Coin(core.MicroLunaDenom, 1000)),
+		staking.NewMsgUndelegate(addr, srcValAddr, sdk.NewInt64Coin(core.MicroLunaDenom, 500)),
+		staking.NewMsgBeginRedelegate(addr, srcValAddr, dstValAddr, sdk.NewInt64Coin(core.MicroLunaDenom, 200)),
+		distribution.NewMsgWithdrawDelegatorReward(addr, srcValAddr),
+		gov.NewMsgVote(addr, 1, gov.OptionYes),
+	}, handled)
+}
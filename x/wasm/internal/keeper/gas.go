@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// meteredStore charges the key and value bytes a contract reads from and writes to its store
+// against the gas limit the contract was called with. Running out of gas inside a callback of
+// the wasm VM would panic across the cgo boundary, so once the limit is reached the store
+// refuses every further access and the call fails after the VM returned.
+type meteredStore struct {
+	prefix.Store
+	costPerByte uint64
+	gasLimit    uint64
+	gasUsed     uint64
+}
+
+func newMeteredStore(store prefix.Store, costPerByte uint64, gasLimit uint64) *meteredStore {
+	return &meteredStore{Store: store, costPerByte: costPerByte, gasLimit: gasLimit}
+}
+
+// Get implements the KVStore interface of the wasm VM
+func (s *meteredStore) Get(key []byte) []byte {
+	if s.outOfGas() {
+		return nil
+	}
+
+	value := s.Store.Get(key)
+	s.consume(len(key) + len(value))
+	return value
+}
+
+// Set implements the KVStore interface of the wasm VM
+func (s *meteredStore) Set(key, value []byte) {
+	if s.consume(len(key) + len(value)); s.outOfGas() {
+		return
+	}
+
+	s.Store.Set(key, value)
+}
+
+func (s *meteredStore) consume(bytes int) {
+	s.gasUsed = addUint64(s.gasUsed, mulUint64(uint64(bytes), s.costPerByte))
+}
+
+func (s *meteredStore) outOfGas() bool {
+	return s.gasUsed > s.gasLimit
+}
+
+// newContractStore returns the metered store of a contract call with the given wasm gas limit
+func (k Keeper) newContractStore(ctx sdk.Context, store prefix.Store, gas uint64) *meteredStore {
+	return newMeteredStore(store, k.StoreCostPerByte(ctx), gas/k.GasMultiplier(ctx))
+}
+
+// consumeStoreGas charges the store access of a contract call, failing the call
+// when the store ran out of gas
+func (k Keeper) consumeStoreGas(ctx sdk.Context, store *meteredStore) sdk.Error {
+	if store.outOfGas() {
+		// the params read since the limit was taken may have left less than the limit
+		meter := ctx.GasMeter()
+		gas := store.gasLimit
+		if remaining := meter.Limit() - meter.GasConsumed(); remaining < gas {
+			gas = remaining
+		}
+
+		meter.ConsumeGas(gas, "wasm contract store")
+		return types.ErrGasLimit(fmt.Sprintf("contract store access exceeds the gas limit %d", store.gasLimit))
+	}
+
+	ctx.GasMeter().ConsumeGas(store.gasUsed, "wasm contract store")
+	return nil
+}
+
+// mulUint64 multiplies the gas amounts, saturating instead of overflowing; the saturated
+// amount is above any gas limit, so consuming it runs out of gas
+func mulUint64(a, b uint64) uint64 {
+	if hi, lo := bits.Mul64(a, b); hi == 0 {
+		return lo
+	}
+	return math.MaxUint64
+}
+
+// addUint64 adds the gas amounts, saturating instead of overflowing
+func addUint64(a, b uint64) uint64 {
+	if sum, carry := bits.Add64(a, b, 0); carry == 0 {
+		return sum
+	}
+	return math.MaxUint64
+}
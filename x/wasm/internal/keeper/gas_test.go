@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// The gas numbers below are what fee estimation relies on; any change of them
+// must be deliberate.
+func TestGasCosts(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	// contract gas depends on the address bytes, so the accounts are fixed
+	fixedAccount := func(b byte, coins sdk.Coins) sdk.AccAddress {
+		addr := sdk.AccAddress(bytes.Repeat([]byte{b}, sdk.AddrLen))
+		acc := accKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetCoins(coins))
+		accKeeper.SetAccount(ctx, acc)
+		return addr
+	}
+	creator := fixedAccount(1, deposit)
+	fred := fixedAccount(2, deposit)
+	bob := sdk.AccAddress(bytes.Repeat([]byte{3}, sdk.AddrLen))
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	gasUsed := func(run func(ctx sdk.Context)) uint64 {
+		meteredCtx := ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+		run(meteredCtx)
+		return meteredCtx.GasMeter().GasConsumed()
+	}
+
+	var codeID uint64
+	storeGas := gasUsed(func(ctx sdk.Context) {
+		var sdkErr sdk.Error
+		codeID, sdkErr = keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+		require.NoError(t, sdkErr)
+	})
+	require.True(t, storeGas > uint64(len(wasmCode))*types.DefaultCompileCostPerByte)
+	require.Equal(t, uint64(136144), storeGas)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	var addr sdk.AccAddress
+	instantiateGas := gasUsed(func(ctx sdk.Context) {
+		var sdkErr sdk.Error
+		addr, sdkErr = keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, deposit)
+		require.NoError(t, sdkErr)
+	})
+	require.Equal(t, uint64(89008), instantiateGas)
+
+	// the release dispatches a single send msg
+	executeGas := gasUsed(func(ctx sdk.Context) {
+		_, sdkErr := keeper.ExecuteContract(ctx, addr, fred, nil, []byte(`{}`))
+		require.NoError(t, sdkErr)
+	})
+	require.Equal(t, uint64(42019), executeGas)
+	require.Equal(t, deposit, accKeeper.GetAccount(ctx, bob).GetCoins())
+}
+
+func TestStoreGasLimit(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	params := keeper.GetParams(ctx)
+	params.StoreCostPerByte = types.MaxStoreCostPerByte
+	keeper.SetParams(ctx, params)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	fred := createFakeFundedAccount(ctx, accKeeper, deposit)
+	bob := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	// the store access of the contract is charged within the gas limit of the call,
+	// which fails instead of running on
+	limit := uint64(200000)
+	meteredCtx := ctx.WithGasMeter(sdk.NewGasMeter(limit))
+	_, sdkErr = keeper.InstantiateContract(meteredCtx, creator, nil, codeID, "demo contract", initMsgBz, nil)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeGasLimit, sdkErr.Code())
+	require.Equal(t, limit, meteredCtx.GasMeter().GasConsumed())
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// RegisterInvariants registers all wasm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-infos", ContractInfosInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sequences", SequencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the wasm module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ContractInfosInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return SequencesInvariant(k)(ctx)
+	}
+}
+
+// ContractInfosInvariant checks that every contract has an account and is
+// instantiated from stored code
+func ContractInfosInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		k.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+			if k.accountKeeper.GetAccount(ctx, contractInfo.Address) == nil {
+				count++
+				msg += fmt.Sprintf("\tcontract %s has no account\n", contractInfo.Address)
+			}
+
+			codeInfo, err := k.GetCodeInfo(ctx, contractInfo.CodeID)
+			if err != nil || len(codeInfo.CodeHash) == 0 || codeInfo.Creator.Empty() {
+				count++
+				msg += fmt.Sprintf("\tcontract %s has invalid code id %d\n", contractInfo.Address, contractInfo.CodeID)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "contract infos", fmt.Sprintf(
+			"%d invalid contracts found\n%s", count, msg)), broken
+	}
+}
+
+// SequencesInvariant checks that the code and instance counters are past all
+// used IDs, so the next code and counter addressed contract cannot collide
+func SequencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		nextCodeID := k.GetNextCodeID(ctx)
+		k.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+			if codeID >= nextCodeID {
+				count++
+				msg += fmt.Sprintf("\tcode id %d is not below the next code id %d\n", codeID, nextCodeID)
+			}
+
+			return false
+		})
+
+		// the instance counter is shared by all codes; salted contracts record instance id zero
+		nextInstanceID := k.GetNextInstanceID(ctx)
+		k.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+			if contractInfo.InstanceID >= nextInstanceID {
+				count++
+				msg += fmt.Sprintf("\tinstance id %d of contract %s is not below the next instance id %d\n",
+					contractInfo.InstanceID, contractInfo.Address, nextInstanceID)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "sequences", fmt.Sprintf(
+			"%d sequence violations found\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func TestInvariants(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, fred := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, sdkErr := keeper.StoreCode(ctx, creator, wasmCode, types.AllowEverybody)
+	require.NoError(t, sdkErr)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	_, sdkErr = keeper.InstantiateContract(ctx, creator, nil, codeID, "demo contract", initMsgBz, nil)
+	require.NoError(t, sdkErr)
+	saltedAddr, sdkErr := keeper.InstantiateContractWithSalt(ctx, creator, nil, codeID, "salted contract", []byte("salt"), initMsgBz, nil)
+	require.NoError(t, sdkErr)
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// rewinding the instance counter makes the next contract collide
+	cacheCtx, _ := ctx.CacheContext()
+	keeper.SetNextInstanceID(cacheCtx, 1)
+	_, broken = SequencesInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// rewinding the code counter reuses the stored code id
+	cacheCtx, _ = ctx.CacheContext()
+	keeper.SetNextCodeID(cacheCtx, codeID)
+	_, broken = SequencesInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// a contract recording an instance id the counter has not reached
+	cacheCtx, _ = ctx.CacheContext()
+	contractInfo, sdkErr := keeper.GetContractInfo(cacheCtx, saltedAddr)
+	require.NoError(t, sdkErr)
+	contractInfo.InstanceID = keeper.GetNextInstanceID(cacheCtx) + 1
+	keeper.SetContractInfo(cacheCtx, saltedAddr, contractInfo)
+	_, broken = SequencesInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// a contract of unknown code
+	cacheCtx, _ = ctx.CacheContext()
+	contractInfo, sdkErr = keeper.GetContractInfo(cacheCtx, saltedAddr)
+	require.NoError(t, sdkErr)
+	contractInfo.CodeID = codeID + 1
+	keeper.SetContractInfo(cacheCtx, saltedAddr, contractInfo)
+	_, broken = ContractInfosInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// a contract without account
+	cacheCtx, _ = ctx.CacheContext()
+	accKeeper.RemoveAccount(cacheCtx, accKeeper.GetAccount(cacheCtx, saltedAddr))
+	_, broken = ContractInfosInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+}
@@ -14,7 +14,8 @@ import (
 // and https://github.com/golang/go/blob/master/src/net/http/sniff.go#L186
 var gzipIdent = []byte("\x1F\x8B\x08")
 
-// uncompress returns gzip uncompressed content or given src when not gzip.
+// uncompress returns gzip uncompressed content or given src when not gzip. Uncompressed
+// content larger than the MaxContractSize param fails with io.ErrUnexpectedEOF.
 func (k Keeper) uncompress(ctx sdk.Context, src []byte) ([]byte, error) {
 	if len(src) < 3 {
 		return src, nil
@@ -30,5 +31,26 @@ func (k Keeper) uncompress(ctx sdk.Context, src []byte) ([]byte, error) {
 	}
 	zr.Multistream(false)
 
-	return ioutil.ReadAll(io.LimitReader(zr, k.MaxContractSize(ctx)))
+	return ioutil.ReadAll(limitReader(zr, k.MaxContractSize(ctx)))
+}
+
+// limitReader returns a Reader that reads from r but stops with io.ErrUnexpectedEOF
+// after n bytes, unlike io.LimitReader which silently truncates the content.
+func limitReader(r io.Reader, n int64) io.Reader {
+	return &limitedReader{r: &io.LimitedReader{R: r, N: n + 1}}
+}
+
+type limitedReader struct {
+	r *io.LimitedReader
+}
+
+func (l *limitedReader) Read(p []byte) (n int, err error) {
+	if l.r.N <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n, err = l.r.Read(p)
+	if l.r.N <= 0 {
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
 }
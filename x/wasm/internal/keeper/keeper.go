@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"path/filepath"
 
@@ -28,17 +29,22 @@ type Keeper struct {
 	paramSpace params.Subspace
 	codespace  sdk.CodespaceType
 
-	accountKeeper auth.AccountKeeper
-	bankKeeper    bank.Keeper
+	accountKeeper  auth.AccountKeeper
+	bankKeeper     bank.Keeper
+	supplyKeeper   types.SupplyKeeper
+	treasuryKeeper types.TreasuryKeeper
 
 	router sdk.Router
 
 	wasmer        wasm.Wasmer
 	queryGasLimit uint64
+
+	authZPolicy authorizationPolicy
 }
 
 // NewKeeper creates a new contract Keeper instance
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramspace params.Subspace, accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, router sdk.Router, queryGasLimit uint64) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramspace params.Subspace, accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper,
+	supplyKeeper types.SupplyKeeper, treasuryKeeper types.TreasuryKeeper, router sdk.Router, queryGasLimit uint64) Keeper {
 	homeDir := viper.GetString(flags.FlagHome)
 	wasmer, err := wasm.NewWasmer(filepath.Join(homeDir, "wasm"), 0)
 
@@ -47,14 +53,17 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramspace params.Subspa
 	}
 
 	return Keeper{
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSpace:    paramspace.WithKeyTable(ParamKeyTable()),
-		wasmer:        *wasmer,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		router:        router,
-		queryGasLimit: queryGasLimit,
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramspace.WithKeyTable(ParamKeyTable()),
+		wasmer:         *wasmer,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		supplyKeeper:   supplyKeeper,
+		treasuryKeeper: treasuryKeeper,
+		router:         router,
+		queryGasLimit:  queryGasLimit,
+		authZPolicy:    defaultAuthorizationPolicy{},
 	}
 }
 
@@ -93,10 +102,34 @@ func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 }
 
 // SetContractInfo stores ContractInfo for the given contractAddress
-func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, codeInfo types.ContractInfo) {
+// and keeps the code and creator indexes of the contract up to date
+func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshalBinaryBare(codeInfo)
+	if prev, err := k.GetContractInfo(ctx, contractAddress); err == nil {
+		store.Delete(types.GetContractByCodeKey(prev.CodeID, contractAddress))
+		store.Delete(types.GetContractByCreatorKey(prev.Creator, contractAddress))
+	}
+
+	b := k.cdc.MustMarshalBinaryBare(contractInfo)
 	store.Set(types.GetContractInfoKey(contractAddress), b)
+	store.Set(types.GetContractByCodeKey(contractInfo.CodeID, contractAddress), []byte{})
+	store.Set(types.GetContractByCreatorKey(contractInfo.Creator, contractAddress), []byte{})
+}
+
+// IterateCodeInfos iterates all code infos in code ID order
+func (k Keeper) IterateCodeInfos(ctx sdk.Context, cb func(codeID uint64, codeInfo types.CodeInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var codeInfo types.CodeInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &codeInfo)
+		// cb returns true to stop early
+		if cb(binary.BigEndian.Uint64(iter.Key()), codeInfo) {
+			break
+		}
+	}
 }
 
 // IterateContractInfo iterates all contract infos
@@ -125,7 +158,7 @@ func (k Keeper) SetContractStore(ctx sdk.Context, contractAddress sdk.AccAddress
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
 	for _, model := range models {
-		prefixStore.Set([]byte(model.Key), []byte(model.Value))
+		prefixStore.Set(model.Key, model.Value)
 	}
 }
 
@@ -17,8 +17,8 @@ func TestCodeInfo(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t)
 
 	codeID := uint64(1)
-	creatorAddr := addrFromUint64(codeID)
-	expected := types.NewCodeInfo([]byte{1, 2, 3}, creatorAddr)
+	creatorAddr := types.ContractAddress(0, codeID)
+	expected := types.NewCodeInfo([]byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
 	keeper.SetCodeInfo(ctx, 1, expected)
 
 	as, err := keeper.GetCodeInfo(ctx, codeID)
@@ -33,8 +33,8 @@ func TestContractInfo(t *testing.T) {
 	_, _, bob := keyPubAddr()
 
 	codeID := uint64(1)
-	creatorAddr := addrFromUint64(codeID)
-	contractAddr := keeper.generateContractAddress(ctx, codeID)
+	creatorAddr := types.ContractAddress(0, codeID)
+	contractAddr, instanceID := keeper.generateContractAddress(ctx, codeID)
 
 	initMsg := InitMsg{
 		Verifier:    alice,
@@ -43,7 +43,7 @@ func TestContractInfo(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	expected := types.NewContractInfo(codeID, contractAddr, creatorAddr, initMsgBz)
+	expected := types.NewContractInfo(codeID, instanceID, contractAddr, creatorAddr, nil, "demo contract", initMsgBz)
 	keeper.SetContractInfo(ctx, contractAddr, expected)
 
 	as, err := keeper.GetContractInfo(ctx, contractAddr)
@@ -59,16 +59,16 @@ func TestContractInfo(t *testing.T) {
 func TestContractStore(t *testing.T) {
 	models := []types.Model{
 		{
-			Key:   "a",
-			Value: "aa",
+			Key:   []byte("a"),
+			Value: []byte("aa"),
 		},
 		{
-			Key:   "b",
-			Value: "bb",
+			Key:   []byte("b"),
+			Value: []byte("bb"),
 		},
 		{
-			Key:   "c",
-			Value: "cc",
+			Key:   []byte("c"),
+			Value: []byte("cc"),
 		},
 	}
 
@@ -80,8 +80,8 @@ func TestContractStore(t *testing.T) {
 	i := 0
 	for iter := keeper.GetContractStoreIterator(ctx, contractAddr); iter.Valid(); iter.Next() {
 		require.Equal(t, models[i], types.Model{
-			Key:   string(iter.Key()),
-			Value: string(iter.Value()),
+			Key:   iter.Key(),
+			Value: iter.Value(),
 		})
 
 		i++
@@ -29,6 +29,36 @@ func (k Keeper) GasMultiplier(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// UploadAccess defines who is allowed to upload wasm code
+func (k Keeper) UploadAccess(ctx sdk.Context) (res types.AccessConfig) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadAccess, &res)
+	return
+}
+
+// CompileCostPerByte defines the sdk gas charged per byte of uploaded bytecode
+func (k Keeper) CompileCostPerByte(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCompileCostPerByte, &res)
+	return
+}
+
+// InstantiateCost defines the sdk gas charged per contract instantiation
+func (k Keeper) InstantiateCost(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyInstantiateCost, &res)
+	return
+}
+
+// StoreCostPerByte defines the sdk gas charged per byte a contract reads from or writes to its store
+func (k Keeper) StoreCostPerByte(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStoreCostPerByte, &res)
+	return
+}
+
+// DispatchCost defines the sdk gas charged per msg a contract dispatches
+func (k Keeper) DispatchCost(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDispatchCost, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
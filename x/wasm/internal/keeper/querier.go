@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -23,6 +28,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryStore(ctx, req, keeper)
 		case types.QueryGetMsg:
 			return queryMsg(ctx, req, keeper)
+		case types.QueryListCodes:
+			return queryListCodes(ctx, req, keeper)
+		case types.QueryListContractsByCode:
+			return queryListContractsByCode(ctx, req, keeper)
+		case types.QueryListContractsByCreator:
+			return queryListContractsByCreator(ctx, req, keeper)
+		case types.QueryListModels:
+			return queryListModels(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown data query endpoint")
 		}
@@ -113,3 +126,155 @@ func queryMsg(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sd
 
 	return keeper.queryToContract(ctx, params.ContractAddress, params.Msg)
 }
+
+func queryListCodes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryListParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	codes := types.CodeInfoResponses{}
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.CodeKey)
+	pageErr := iteratePage(prefixStore, params.Page, params.Limit, func(key, value []byte) {
+		var codeInfo types.CodeInfo
+		keeper.cdc.MustUnmarshalBinaryBare(value, &codeInfo)
+		codes = append(codes, types.CodeInfoResponse{
+			CodeID:   binary.BigEndian.Uint64(key),
+			CodeHash: codeInfo.CodeHash,
+			Creator:  codeInfo.Creator,
+		})
+	})
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, codes)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	return bz, nil
+}
+
+func queryListContractsByCode(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryContractsByCodeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	return listContracts(ctx, keeper, types.GetContractsByCodePrefix(params.CodeID), params.Page, params.Limit)
+}
+
+func queryListContractsByCreator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryContractsByCreatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	return listContracts(ctx, keeper, types.GetContractsByCreatorPrefix(params.Creator), params.Page, params.Limit)
+}
+
+// listContracts returns the requested page of the contracts in the index under indexPrefix
+func listContracts(ctx sdk.Context, keeper Keeper, indexPrefix []byte, page, limit int) ([]byte, sdk.Error) {
+	contracts := types.ContractInfos{}
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), indexPrefix)
+	pageErr := iteratePage(prefixStore, page, limit, func(key, _ []byte) {
+		contractInfo, err := keeper.GetContractInfo(ctx, sdk.AccAddress(key))
+		if err != nil {
+			panic(err)
+		}
+		contracts = append(contracts, contractInfo)
+	})
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, contracts)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	return bz, nil
+}
+
+// queryLimit returns the page size for the requested limit, defaulting a non-positive limit
+// and rejecting one above MaxQueryLimit
+func queryLimit(limit int) (int, sdk.Error) {
+	if limit <= 0 {
+		return types.DefaultQueryLimit, nil
+	}
+	if limit > types.MaxQueryLimit {
+		return 0, sdk.ErrUnknownRequest(fmt.Sprintf("limit %d exceeds the maximum of %d", limit, types.MaxQueryLimit))
+	}
+	return limit, nil
+}
+
+// iteratePage calls cb with the entries of the 1-indexed page of the store in key order,
+// skipping the entries of the previous pages without decoding them
+func iteratePage(store sdk.KVStore, page, limit int, cb func(key, value []byte)) sdk.Error {
+	limit, err := queryLimit(limit)
+	if err != nil {
+		return err
+	}
+	if page <= 0 {
+		return nil
+	}
+	// the entries to skip must fit in an int
+	if page-1 > math.MaxInt32/limit {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("page %d is out of range", page))
+	}
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for skip := (page - 1) * limit; skip > 0 && iter.Valid(); skip-- {
+		iter.Next()
+	}
+
+	for n := 0; n < limit && iter.Valid(); n++ {
+		cb(iter.Key(), iter.Value())
+		iter.Next()
+	}
+	return nil
+}
+
+func queryListModels(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryModelsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	if _, err := keeper.GetContractInfo(ctx, params.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	limit, limitErr := queryLimit(params.Limit)
+	if limitErr != nil {
+		return nil, limitErr
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractStoreKey(params.ContractAddress))
+	iter := prefixStore.Iterator(params.StartKey, nil)
+	defer iter.Close()
+
+	res := types.ModelsResponse{Models: []types.Model{}}
+	for ; iter.Valid(); iter.Next() {
+		if len(res.Models) == limit {
+			res.NextKey = iter.Key()
+			break
+		}
+
+		res.Models = append(res.Models, types.Model{
+			Key:   iter.Key(),
+			Value: iter.Value(),
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	return bz, nil
+}
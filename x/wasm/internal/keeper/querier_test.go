@@ -1 +1,133 @@
 package keeper
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func TestQueryListings(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, _, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+
+	query := func(route string, params interface{}, res interface{}) sdk.Error {
+		bz, err := types.ModuleCdc.MarshalJSON(params)
+		require.NoError(t, err)
+
+		resBz, sdkErr := querier(ctx, []string{route}, abci.RequestQuery{Data: bz})
+		if sdkErr != nil {
+			return sdkErr
+		}
+
+		require.NoError(t, keeper.cdc.UnmarshalJSON(resBz, res))
+		return nil
+	}
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	// three codes, the first two instantiated by alice and the last one by bob
+	var contracts []types.ContractInfo
+	for i := 0; i < 3; i++ {
+		codeID := keeper.increaseLastCodeID(ctx)
+		keeper.SetCodeInfo(ctx, codeID, types.NewCodeInfo([]byte{byte(i)}, alice, types.AllowEverybody))
+
+		creator := alice
+		if i == 2 {
+			creator = bob
+		}
+
+		contractAddr, instanceID := keeper.generateContractAddress(ctx, codeID)
+		contractInfo := types.NewContractInfo(codeID, instanceID, contractAddr, creator, creator, "demo contract", []byte(`{}`))
+		keeper.SetContractInfo(ctx, contractAddr, contractInfo)
+		contracts = append(contracts, contractInfo)
+	}
+
+	var codes types.CodeInfoResponses
+	require.NoError(t, query(types.QueryListCodes, types.NewQueryListParams(1, 0), &codes))
+	require.Len(t, codes, 3)
+	require.Equal(t, types.CodeInfoResponse{CodeID: 1, CodeHash: []byte{0}, Creator: alice}, codes[0])
+
+	require.NoError(t, query(types.QueryListCodes, types.NewQueryListParams(2, 2), &codes))
+	require.Len(t, codes, 1)
+	require.Equal(t, uint64(3), codes[0].CodeID)
+
+	require.NoError(t, query(types.QueryListCodes, types.NewQueryListParams(3, 2), &codes))
+	require.Empty(t, codes)
+
+	// limits above the maximum and pages past the int range are rejected
+	require.Error(t, query(types.QueryListCodes, types.NewQueryListParams(1, types.MaxQueryLimit+1), &codes))
+	require.Error(t, query(types.QueryListCodes, types.NewQueryListParams(math.MaxInt32, 2), &codes))
+	require.NoError(t, query(types.QueryListCodes, types.NewQueryListParams(2, types.MaxQueryLimit), &codes))
+	require.Empty(t, codes)
+
+	var listed types.ContractInfos
+	require.NoError(t, query(types.QueryListContractsByCode, types.NewQueryContractsByCodeParams(2, 1, 0), &listed))
+	require.Equal(t, types.ContractInfos{contracts[1]}, listed)
+
+	require.NoError(t, query(types.QueryListContractsByCreator, types.NewQueryContractsByCreatorParams(alice, 1, 0), &listed))
+	require.Len(t, listed, 2)
+
+	require.NoError(t, query(types.QueryListContractsByCreator, types.NewQueryContractsByCreatorParams(alice, 2, 1), &listed))
+	require.Len(t, listed, 1)
+
+	require.NoError(t, query(types.QueryListContractsByCreator, types.NewQueryContractsByCreatorParams(bob, 1, 0), &listed))
+	require.Equal(t, types.ContractInfos{contracts[2]}, listed)
+
+	// a contract info stored again with another code is listed under the new code only
+	rewritten := contracts[1]
+	rewritten.CodeID = 3
+	keeper.SetContractInfo(ctx, rewritten.Address, rewritten)
+
+	require.NoError(t, query(types.QueryListContractsByCode, types.NewQueryContractsByCodeParams(2, 1, 0), &listed))
+	require.Empty(t, listed)
+
+	require.NoError(t, query(types.QueryListContractsByCode, types.NewQueryContractsByCodeParams(3, 1, 0), &listed))
+	require.Len(t, listed, 2)
+	require.Contains(t, listed, rewritten)
+
+	require.NoError(t, query(types.QueryListContractsByCreator, types.NewQueryContractsByCreatorParams(alice, 1, 0), &listed))
+	require.Len(t, listed, 2)
+
+	// models are paged by start key
+	contractAddr := contracts[0].Address
+	// keys and values are raw bytes, which need not be valid UTF-8
+	keeper.SetContractStore(ctx, contractAddr, []types.Model{
+		{Key: []byte{0x00, 0xff}, Value: []byte{0xfe}},
+		{Key: []byte{0x01, 0xff}, Value: []byte("2")},
+		{Key: []byte{0x02, 0xff}, Value: []byte("3")},
+	})
+
+	var models types.ModelsResponse
+	require.NoError(t, query(types.QueryListModels, types.NewQueryModelsParams(contractAddr, nil, 2), &models))
+	require.Equal(t, []types.Model{
+		{Key: []byte{0x00, 0xff}, Value: []byte{0xfe}},
+		{Key: []byte{0x01, 0xff}, Value: []byte("2")},
+	}, models.Models)
+	require.Equal(t, []byte{0x02, 0xff}, models.NextKey)
+
+	require.NoError(t, query(types.QueryListModels, types.NewQueryModelsParams(contractAddr, models.NextKey, 2), &models))
+	require.Equal(t, []types.Model{{Key: []byte{0x02, 0xff}, Value: []byte("3")}}, models.Models)
+	require.Empty(t, models.NextKey)
+
+	require.Error(t, query(types.QueryListModels, types.NewQueryModelsParams(contractAddr, nil, types.MaxQueryLimit+1), &models))
+
+	_, _, unknown := keyPubAddr()
+	sdkErr := query(types.QueryListModels, types.NewQueryModelsParams(unknown, nil, 0), &models)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeNotFound, sdkErr.Code())
+}
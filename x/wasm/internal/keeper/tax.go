@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/treasury"
+)
+
+// payTax collects the stability tax on coins transferred from sender to recipient by a contract.
+// The tax is charged from the sender's balance on top of the transferred coins, the same way as
+// the ante handler charges it on top of a bank send through the tx fee, and fails when the
+// balance is short. The tax is sent to the fee collector and recorded as tax proceeds of the epoch.
+func (k Keeper) payTax(ctx sdk.Context, sender, recipient sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	outputs := []bank.Output{bank.NewOutput(recipient, coins)}
+	taxable := treasury.FilterTaxExemptCoinsFromKeeper(ctx, k.treasuryKeeper, sender, outputs, coins)
+	taxes := treasury.ComputeTaxFromKeeper(ctx, k.treasuryKeeper, taxable)
+	if taxes.Empty() {
+		return nil
+	}
+
+	// the balance must cover the transfer and the tax together
+	required := coins.Add(taxes)
+	if balance := k.bankKeeper.GetCoins(ctx, sender); !balance.IsAllGTE(required) {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("insufficient balance to pay tax: %s < %s", balance, required))
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, auth.FeeCollectorName, taxes); err != nil {
+		return err
+	}
+
+	k.treasuryKeeper.RecordEpochTaxProceeds(ctx, taxes)
+	k.treasuryKeeper.RecordTaxPayment(ctx, sender, taxes)
+	return nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func TestDispatchSendPaysTax(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	tk := NewDummyTreasuryKeeper().WithTaxRate(sdk.NewDecWithPrec(1, 2)) // 1%
+	keeper.treasuryKeeper = tk
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000), sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+	contractAddr := createFakeFundedAccount(ctx, accKeeper, funds)
+	_, _, bob := keyPubAddr()
+
+	sent := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 40000), sdk.NewInt64Coin(core.MicroSDRDenom, 40000))
+	sendMsg := wasmTypes.CosmosMsg{
+		Send: wasmTypes.SendMsg{
+			FromAddress: contractAddr.String(),
+			ToAddress:   bob.String(),
+			Amount:      types.NewWasmCoins(sent),
+		},
+	}
+
+	err = keeper.dispatchMessage(ctx, accKeeper.GetAccount(ctx, contractAddr), sendMsg)
+	require.NoError(t, err)
+
+	// luna is not taxed; the tax is charged on top of the sent coins
+	tax := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 400))
+	require.Equal(t, tax, tk.GetTaxProceeds())
+	require.Equal(t, tax, accKeeper.GetAccount(ctx, supply.NewModuleAddress(auth.FeeCollectorName)).GetCoins())
+	require.Equal(t, sent, accKeeper.GetAccount(ctx, bob).GetCoins())
+	require.Equal(t, funds.Sub(sent).Sub(tax), accKeeper.GetAccount(ctx, contractAddr).GetCoins())
+
+	// exempted pair does not pay the tax
+	tk.SetTaxExempt(contractAddr, bob, core.MicroSDRDenom)
+	err = keeper.dispatchMessage(ctx, accKeeper.GetAccount(ctx, contractAddr), sendMsg)
+	require.NoError(t, err)
+	require.Equal(t, tax, tk.GetTaxProceeds())
+	require.Equal(t, sent.Add(sent), accKeeper.GetAccount(ctx, bob).GetCoins())
+	require.Equal(t, funds.Sub(sent).Sub(tax).Sub(sent), accKeeper.GetAccount(ctx, contractAddr).GetCoins())
+}
+
+func TestDispatchSendShortOfTax(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	ctx, accKeeper, keeper := CreateTestInput(t)
+
+	tk := NewDummyTreasuryKeeper().WithTaxRate(sdk.NewDecWithPrec(1, 2)) // 1%
+	keeper.treasuryKeeper = tk
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+	contractAddr := createFakeFundedAccount(ctx, accKeeper, funds)
+	_, _, bob := keyPubAddr()
+
+	// the whole balance leaves nothing to pay the tax with
+	sendMsg := wasmTypes.CosmosMsg{
+		Send: wasmTypes.SendMsg{
+			FromAddress: contractAddr.String(),
+			ToAddress:   bob.String(),
+			Amount:      types.NewWasmCoins(funds),
+		},
+	}
+
+	err = keeper.dispatchMessage(ctx, accKeeper.GetAccount(ctx, contractAddr), sendMsg)
+	require.Error(t, err)
+
+	require.True(t, tk.GetTaxProceeds().Empty())
+	require.Nil(t, accKeeper.GetAccount(ctx, bob))
+	require.Equal(t, funds, accKeeper.GetAccount(ctx, contractAddr).GetCoins())
+}
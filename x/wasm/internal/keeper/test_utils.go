@@ -11,6 +11,7 @@ import (
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/stretchr/testify/require"
 
@@ -29,6 +30,7 @@ func makeTestCodec() *codec.Codec {
 	// Register AppAccount
 	cdc.RegisterInterface((*authexported.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/wasm/BaseAccount", nil)
+	cdc.RegisterConcrete(&supply.ModuleAccount{}, "test/wasm/ModuleAccount", nil)
 	codec.RegisterCrypto(cdc)
 
 	return cdc
@@ -39,6 +41,7 @@ func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
 	keyContract := sdk.NewKVStoreKey(types.StoreKey)
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 
 	db := dbm.NewMemDB()
@@ -46,6 +49,7 @@ func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
 	ms.MountStoreWithDB(keyContract, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -70,6 +74,13 @@ func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
 	)
 	bk.SetSendEnabled(ctx, true)
 
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+	}
+
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bk, maccPerms)
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+
 	// TODO: register more than bank.send
 	router := baseapp.NewRouter()
 	h := bank.NewHandler(bk)
@@ -81,6 +92,8 @@ func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
 		pk.Subspace(types.DefaultParamspace),
 		accountKeeper,
 		bk,
+		supplyKeeper,
+		NewDummyTreasuryKeeper(),
 		router,
 		uint64(3000000),
 	)
@@ -111,3 +124,62 @@ func keyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
 	addr := sdk.AccAddress(pub.Address())
 	return key, pub, addr
 }
+
+// DummyTreasuryKeeper no-lint
+type DummyTreasuryKeeper struct {
+	taxRate       sdk.Dec
+	taxCap        sdk.Int
+	taxProceeds   *sdk.Coins
+	taxExemptions map[string]bool
+}
+
+// NewDummyTreasuryKeeper no-lint
+func NewDummyTreasuryKeeper() DummyTreasuryKeeper {
+	return DummyTreasuryKeeper{
+		taxRate:       sdk.ZeroDec(),
+		taxCap:        sdk.NewInt(1000000),
+		taxProceeds:   &sdk.Coins{},
+		taxExemptions: make(map[string]bool),
+	}
+}
+
+// WithTaxRate no-lint
+func (tk DummyTreasuryKeeper) WithTaxRate(taxRate sdk.Dec) DummyTreasuryKeeper {
+	tk.taxRate = taxRate
+	return tk
+}
+
+// SetTaxExempt no-lint
+func (tk DummyTreasuryKeeper) SetTaxExempt(sender, recipient sdk.AccAddress, denom string) {
+	tk.taxExemptions[sender.String()+recipient.String()+denom] = true
+}
+
+// GetDenomTaxRate no-lint
+func (tk DummyTreasuryKeeper) GetDenomTaxRate(_ sdk.Context, _ string) (rate sdk.Dec) {
+	return tk.taxRate
+}
+
+// GetTaxCap no-lint
+func (tk DummyTreasuryKeeper) GetTaxCap(_ sdk.Context, _ string) (taxCap sdk.Int) {
+	return tk.taxCap
+}
+
+// RecordEpochTaxProceeds no-lint
+func (tk DummyTreasuryKeeper) RecordEpochTaxProceeds(_ sdk.Context, delta sdk.Coins) {
+	*tk.taxProceeds = tk.taxProceeds.Add(delta)
+}
+
+// RecordTaxPayment no-lint
+func (tk DummyTreasuryKeeper) RecordTaxPayment(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	return
+}
+
+// GetTaxProceeds no-lint
+func (tk DummyTreasuryKeeper) GetTaxProceeds() sdk.Coins {
+	return *tk.taxProceeds
+}
+
+// IsTaxExempt no-lint
+func (tk DummyTreasuryKeeper) IsTaxExempt(_ sdk.Context, sender, recipient sdk.AccAddress, denom string) bool {
+	return tk.taxExemptions[sender.String()+denom] || tk.taxExemptions[sender.String()+recipient.String()+denom]
+}
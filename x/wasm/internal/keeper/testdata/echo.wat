@@ -0,0 +1,67 @@
+;; echo.wasm is a minimal cosmwasm 0.6 contract which stores the last msg it
+;; handled under the key "msg" of its store, so tests can read back what the
+;; chain sent to a contract. Every call succeeds without messages or log.
+;;
+;; The function of every export must be indexed below the number of functions
+;; defined here, which the export check of the wasm VM assumes, so ok_result
+;; comes last.
+(module
+  (type $t0 (func (param i32 i32) (result i32)))
+  (type $t1 (func (param i32 i32)))
+  (type $t2 (func (param i32) (result i32)))
+  (type $t3 (func (param i32)))
+  (type $t4 (func (result i32)))
+  (import "env" "c_write" (func $c_write (type $t1)))
+  (memory (export "memory") 17)
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; bump allocates the data followed by its 4-byte aligned region
+  (func $allocate (export "allocate") (type $t2) (param $size i32) (result i32)
+    (local $region i32)
+    global.get $heap
+    local.get $size
+    i32.add
+    i32.const 3
+    i32.add
+    i32.const -4
+    i32.and
+    local.set $region
+    local.get $region
+    global.get $heap
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 8
+    i32.add
+    global.set $heap
+    local.get $region)
+
+  (func $deallocate (export "deallocate") (type $t3) (param $region i32))
+
+  (func $cosmwasm_api_0_6 (export "cosmwasm_api_0_6") (type $t4) (result i32)
+    i32.const 0)
+
+  (func $init (export "init") (type $t0) (param $params i32) (param $msg i32) (result i32)
+    call $ok_result)
+
+  (func $handle (export "handle") (type $t0) (param $params i32) (param $msg i32) (result i32)
+    i32.const 256
+    local.get $msg
+    call $c_write
+    call $ok_result)
+
+  (func $query (export "query") (type $t2) (param $msg i32) (result i32)
+    i32.const 272)
+
+  (func $ok_result (type $t4) (result i32)
+    i32.const 264)
+
+  (data (i32.const 16) "msg")
+  (data (i32.const 32) "{\"ok\":{\"messages\":[],\"log\":\"\",\"data\":\"\"}}")
+  (data (i32.const 128) "{\"ok\":\"\"}")
+  ;; regions of the key, the handle result and the query result
+  (data (i32.const 256) "\10\00\00\00\03\00\00\00")
+  (data (i32.const 264) "\20\00\00\00\29\00\00\00")
+  (data (i32.const 272) "\80\00\00\00\09\00\00\00"))
@@ -0,0 +1,74 @@
+;; terra_msgs.wasm is a minimal cosmwasm 0.6 contract whose handle returns one
+;; opaque Terra msg of every kind the keeper encodes, so tests can check the
+;; dispatch of contract emitted msgs through a real execute. The base64 data of
+;; the msgs, in order, decodes to
+;;   {"swap":{"offer_coin":{"denom":"uluna","amount":"1000"},"ask_denom":"usdr"}}
+;;   {"delegate":{"validator":"cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0","amount":{"denom":"uluna","amount":"1000"}}}
+;;   {"undelegate":{"validator":"cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0","amount":{"denom":"uluna","amount":"500"}}}
+;;   {"redelegate":{"src_validator":"cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0","dst_validator":"cosmosvaloper1qgpqyqszqgpqyqszqgpqyqszqgpqyqszxrnw2e","amount":{"denom":"uluna","amount":"200"}}}
+;;   {"withdraw_delegator_reward":{"validator":"cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0"}}
+;;   {"vote":{"proposal_id":1,"option":"yes"}}
+;; where the validators are the 20 byte addresses of all 1s and all 2s.
+;;
+;; The function of every export must be indexed below the number of functions
+;; defined here, which the export check of the wasm VM assumes.
+(module
+  (type $t0 (func (param i32 i32) (result i32)))
+  (type $t1 (func (param i32) (result i32)))
+  (type $t2 (func (param i32)))
+  (type $t3 (func (result i32)))
+  (memory (export "memory") 17)
+  (global $heap (mut i32) (i32.const 4096))
+
+  ;; bump allocates the data followed by its 4-byte aligned region
+  (func $allocate (export "allocate") (type $t1) (param $size i32) (result i32)
+    (local $region i32)
+    global.get $heap
+    local.get $size
+    i32.add
+    i32.const 3
+    i32.add
+    i32.const -4
+    i32.and
+    local.set $region
+    local.get $region
+    global.get $heap
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 8
+    i32.add
+    global.set $heap
+    local.get $region)
+
+  (func $deallocate (export "deallocate") (type $t2) (param $region i32))
+
+  (func $cosmwasm_api_0_6 (export "cosmwasm_api_0_6") (type $t3) (result i32)
+    i32.const 0)
+
+  (func $init (export "init") (type $t0) (param $params i32) (param $msg i32) (result i32)
+    i32.const 16)
+
+  (func $handle (export "handle") (type $t0) (param $params i32) (param $msg i32) (result i32)
+    i32.const 24)
+
+  (func $query (export "query") (type $t1) (param $msg i32) (result i32)
+    i32.const 32)
+
+  ;; regions of the init result, the handle result and the query result
+  (data (i32.const 16) "\40\00\00\00\29\00\00\00")
+  (data (i32.const 24) "\00\01\00\00\32\04\00\00")
+  (data (i32.const 32) "\80\00\00\00\09\00\00\00")
+  (data (i32.const 64) "{\"ok\":{\"messages\":[],\"log\":\"\",\"data\":\"\"}}")
+  (data (i32.const 128) "{\"ok\":\"\"}")
+  (data (i32.const 256)
+    "{\"ok\":{\"messages\":["
+    "{\"opaque\":{\"data\":\"eyJzd2FwIjp7Im9mZmVyX2NvaW4iOnsiZGVub20iOiJ1bHVuYSIsImFtb3VudCI6IjEwMDAifSwiYXNrX2Rlbm9tIjoidXNkciJ9fQ==\"}},"
+    "{\"opaque\":{\"data\":\"eyJkZWxlZ2F0ZSI6eyJ2YWxpZGF0b3IiOiJjb3Ntb3N2YWxvcGVyMXF5cXN6cWdwcXlxc3pxZ3BxeXFzenFncHF5cXN6cWdwaDg0dHAwIiwiYW1vdW50Ijp7ImRlbm9tIjoidWx1bmEiLCJhbW91bnQiOiIxMDAwIn19fQ==\"}},"
+    "{\"opaque\":{\"data\":\"eyJ1bmRlbGVnYXRlIjp7InZhbGlkYXRvciI6ImNvc21vc3ZhbG9wZXIxcXlxc3pxZ3BxeXFzenFncHF5cXN6cWdwcXlxc3pxZ3BoODR0cDAiLCJhbW91bnQiOnsiZGVub20iOiJ1bHVuYSIsImFtb3VudCI6IjUwMCJ9fX0=\"}},"
+    "{\"opaque\":{\"data\":\"eyJyZWRlbGVnYXRlIjp7InNyY192YWxpZGF0b3IiOiJjb3Ntb3N2YWxvcGVyMXF5cXN6cWdwcXlxc3pxZ3BxeXFzenFncHF5cXN6cWdwaDg0dHAwIiwiZHN0X3ZhbGlkYXRvciI6ImNvc21vc3ZhbG9wZXIxcWdwcXlxc3pxZ3BxeXFzenFncHF5cXN6cWdwcXlxc3p4cm53MmUiLCJhbW91bnQiOnsiZGVub20iOiJ1bHVuYSIsImFtb3VudCI6IjIwMCJ9fX0=\"}},"
+    "{\"opaque\":{\"data\":\"eyJ3aXRoZHJhd19kZWxlZ2F0b3JfcmV3YXJkIjp7InZhbGlkYXRvciI6ImNvc21vc3ZhbG9wZXIxcXlxc3pxZ3BxeXFzenFncHF5cXN6cWdwcXlxc3pxZ3BoODR0cDAifX0=\"}},"
+    "{\"opaque\":{\"data\":\"eyJ2b3RlIjp7InByb3Bvc2FsX2lkIjoxLCJvcHRpb24iOiJ5ZXMifX0=\"}}"
+    "],\"log\":\"\",\"data\":\"\"}}"))
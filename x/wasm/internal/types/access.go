@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccessType defines who is allowed to perform a wasm operation
+type AccessType string
+
+// Supported access types
+const (
+	AccessTypeEverybody AccessType = "everybody"
+	AccessTypeNobody    AccessType = "nobody"
+	AccessTypeAllowList AccessType = "allow_list"
+)
+
+// AccessConfig is an access policy for uploading or instantiating wasm code
+type AccessConfig struct {
+	Type      AccessType       `json:"type" yaml:"type"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"` // only used with AccessTypeAllowList
+}
+
+// Predefined access configs
+var (
+	AllowEverybody = AccessConfig{Type: AccessTypeEverybody}
+	AllowNobody    = AccessConfig{Type: AccessTypeNobody}
+)
+
+// NewAllowListAccess returns an AccessConfig permitting only the given addresses
+func NewAllowListAccess(addresses ...sdk.AccAddress) AccessConfig {
+	return AccessConfig{
+		Type:      AccessTypeAllowList,
+		Addresses: addresses,
+	}
+}
+
+// Allowed returns whether the address is permitted by the access config
+func (ac AccessConfig) Allowed(addr sdk.AccAddress) bool {
+	switch ac.Type {
+	case AccessTypeEverybody:
+		return true
+	case AccessTypeAllowList:
+		for _, allowed := range ac.Addresses {
+			if allowed.Equals(addr) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Validate performs basic validation of the access config
+func (ac AccessConfig) Validate() error {
+	switch ac.Type {
+	case AccessTypeEverybody, AccessTypeNobody:
+		if len(ac.Addresses) != 0 {
+			return fmt.Errorf("access type %s must not have addresses", ac.Type)
+		}
+	case AccessTypeAllowList:
+		if len(ac.Addresses) == 0 {
+			return fmt.Errorf("access type %s requires at least one address", ac.Type)
+		}
+		for _, addr := range ac.Addresses {
+			if addr.Empty() {
+				return fmt.Errorf("access type %s contains an empty address", ac.Type)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown access type %q", ac.Type)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (ac AccessConfig) String() string {
+	if ac.Type != AccessTypeAllowList {
+		return string(ac.Type)
+	}
+
+	addrs := make([]string, len(ac.Addresses))
+	for i, addr := range ac.Addresses {
+		addrs[i] = addr.String()
+	}
+	return fmt.Sprintf("%s [%s]", ac.Type, strings.Join(addrs, ", "))
+}
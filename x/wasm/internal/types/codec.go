@@ -2,6 +2,8 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/terra-project/core/x/gov"
 )
 
 // RegisterCodec registers the wasm types and interface
@@ -9,6 +11,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgStoreCode{}, "wasm/StoreCode", nil)
 	cdc.RegisterConcrete(&MsgInstantiateContract{}, "wasm/InstantiateContract", nil)
 	cdc.RegisterConcrete(&MsgExecuteContract{}, "wasm/ExecuteContract", nil)
+	cdc.RegisterConcrete(&MsgUpdateContractAdmin{}, "wasm/UpdateContractAdmin", nil)
+	cdc.RegisterConcrete(&MsgClearContractAdmin{}, "wasm/ClearContractAdmin", nil)
+
+	cdc.RegisterConcrete(StoreCodeProposal{}, "wasm/StoreCodeProposal", nil)
+	cdc.RegisterConcrete(InstantiateContractProposal{}, "wasm/InstantiateContractProposal", nil)
+	cdc.RegisterConcrete(ExecuteContractProposal{}, "wasm/ExecuteContractProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -19,4 +27,8 @@ func init() {
 	RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	ModuleCdc = cdc.Seal()
+
+	gov.RegisterProposalTypeCodec(StoreCodeProposal{}, "wasm/StoreCodeProposal")
+	gov.RegisterProposalTypeCodec(InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
+	gov.RegisterProposalTypeCodec(ExecuteContractProposal{}, "wasm/ExecuteContractProposal")
 }
@@ -1,64 +1,81 @@
 package types
 
 import (
+	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
 	wasmTypes "github.com/confio/go-cosmwasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/tendermint/tendermint/crypto"
 )
 
-// Model is a struct that holds a KV pair
+// Model is a struct that holds a KV pair of a contract store
 type Model struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
 }
 
 func (m Model) String() string {
 	return fmt.Sprintf(`Model
-	Key:   %s,
-	Value: %s`,
+	Key:   %X,
+	Value: %X`,
 		m.Key, m.Value)
 }
 
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
-	CodeHash []byte         `json:"code_hash"`
-	Creator  sdk.AccAddress `json:"creator"`
+	CodeHash              []byte         `json:"code_hash"`
+	Creator               sdk.AccAddress `json:"creator"`
+	InstantiatePermission AccessConfig   `json:"instantiate_permission"`
 }
 
 // String implements fmt.Stringer interface
 func (ci CodeInfo) String() string {
 	return fmt.Sprintf(`CodeInfo
-	CodeHash:    %s, 
-	Creator:     %s`,
-		ci.CodeHash, ci.Creator)
+	CodeHash:              %s, 
+	Creator:               %s,
+	InstantiatePermission: %s`,
+		ci.CodeHash, ci.Creator, ci.InstantiatePermission)
 }
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeHash []byte, creator sdk.AccAddress) CodeInfo {
+func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
-		CodeHash: codeHash,
-		Creator:  creator,
+		CodeHash:              codeHash,
+		Creator:               creator,
+		InstantiatePermission: instantiatePermission,
 	}
 }
 
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
-	CodeID  uint64         `json:"code_id"`
-	Address sdk.AccAddress `json:"address"`
-	Creator sdk.AccAddress `json:"creator"`
-	InitMsg []byte         `json:"init_msg"`
+	CodeID uint64 `json:"code_id"`
+	// InstanceID is the value of the instance counter the address was derived from,
+	// zero for a contract instantiated with a salt
+	InstanceID uint64         `json:"instance_id"`
+	Address    sdk.AccAddress `json:"address"`
+	Creator    sdk.AccAddress `json:"creator"`
+	InitMsg    []byte         `json:"init_msg"`
+	// Admin is allowed to change or clear the admin; it is recorded for the contract
+	// migrations the wasm VM in use cannot run yet
+	Admin sdk.AccAddress `json:"admin"`
+	// Label is a human readable name of the contract
+	Label string `json:"label"`
 }
 
 // NewContractInfo creates a new instance of a given WASM contract info
-func NewContractInfo(codeID uint64, address sdk.AccAddress, creator sdk.AccAddress, initMsg []byte) ContractInfo {
+func NewContractInfo(codeID uint64, instanceID uint64, address sdk.AccAddress, creator sdk.AccAddress, admin sdk.AccAddress, label string, initMsg []byte) ContractInfo {
 	return ContractInfo{
-		CodeID:  codeID,
-		Address: address,
-		Creator: creator,
-		InitMsg: initMsg,
+		CodeID:     codeID,
+		InstanceID: instanceID,
+		Address:    address,
+		Creator:    creator,
+		InitMsg:    initMsg,
+		Admin:      admin,
+		Label:      label,
 	}
 }
 
@@ -66,9 +83,35 @@ func NewContractInfo(codeID uint64, address sdk.AccAddress, creator sdk.AccAddre
 func (ci ContractInfo) String() string {
 	return fmt.Sprintf(`ContractInfo
 	CodeID:     %d, 
+	InstanceID: %d,
 	Creator:    %s,
+	Admin:      %s,
+	Label:      %s,
 	InitMsg:    %s`,
-		ci.CodeID, ci.Creator, hex.EncodeToString(ci.InitMsg))
+		ci.CodeID, ci.InstanceID, ci.Creator, ci.Admin, ci.Label, hex.EncodeToString(ci.InitMsg))
+}
+
+// ContractAddress derives the address of a contract instantiated from the instance counter
+// from the code it was instantiated with and the value of the counter.
+//
+// NOTE: It is possible to get a duplicate address if either codeID or instanceID
+// overflow 32 bits. This is highly improbable, but something that could be refactored.
+func ContractAddress(codeID uint64, instanceID uint64) sdk.AccAddress {
+	addr := make([]byte, 20)
+	addr[0] = 'C'
+	binary.PutUvarint(addr[1:], codeID<<32+instanceID)
+	return sdk.AccAddress(crypto.AddressHash(addr))
+}
+
+// PredictableContractAddress derives the address of a contract instantiated with a salt from the
+// creator, the code hash and the salt, so it is known before the contract is instantiated
+func PredictableContractAddress(creator sdk.AccAddress, codeHash []byte, salt []byte) sdk.AccAddress {
+	bz := []byte("wasm/salted")
+	for _, part := range [][]byte{creator, codeHash, salt} {
+		bz = append(bz, sdk.Uint64ToBigEndian(uint64(len(part)))...)
+		bz = append(bz, part...)
+	}
+	return sdk.AccAddress(crypto.AddressHash(bz))
 }
 
 // NewWasmAPIParams initializes params for a contract instance
@@ -101,3 +144,34 @@ func NewWasmCoins(cosmosCoins sdk.Coins) (wasmCoins []wasmTypes.Coin) {
 	}
 	return wasmCoins
 }
+
+// LogAttribute is a key value pair of the log a contract returns
+type LogAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewContractLogEvent converts the log a contract returned to a from_contract event,
+// which always carries the contract address. A log holding a JSON list of LogAttribute
+// adds one attribute per entry, any other non empty log a single log attribute.
+func NewContractLogEvent(contractAddr sdk.AccAddress, log string) sdk.Event {
+	event := sdk.NewEvent(
+		EventTypeFromContract,
+		sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+	)
+
+	if len(log) == 0 {
+		return event
+	}
+
+	var attributes []LogAttribute
+	if err := json.Unmarshal([]byte(log), &attributes); err != nil {
+		return event.AppendAttributes(sdk.NewAttribute(AttributeKeyLog, log))
+	}
+
+	for _, attr := range attributes {
+		event = event.AppendAttributes(sdk.NewAttribute(attr.Key, attr.Value))
+	}
+
+	return event
+}
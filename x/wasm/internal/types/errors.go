@@ -17,6 +17,9 @@ const (
 	CodeGasLimit          sdk.CodeType = 5
 	CodeInvalidGenesis    sdk.CodeType = 6
 	CodeNotFound          sdk.CodeType = 7
+	CodeUnauthorized      sdk.CodeType = 8
+	CodeUploadDenied      sdk.CodeType = 9
+	CodeInstantiateDenied sdk.CodeType = 10
 )
 
 // ErrCreateFailed error for wasm code that has already been uploaded or failed
@@ -53,3 +56,18 @@ func ErrInvalidGenesis(msg string) sdk.Error {
 func ErrNotFound(msg string) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeNotFound, fmt.Sprintf("not found: %s", msg))
 }
+
+// ErrUnauthorized error for a contract admin operation sent by someone other than the admin
+func ErrUnauthorized(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeUnauthorized, fmt.Sprintf("unauthorized: %s", msg))
+}
+
+// ErrUploadDenied error for an upload by an address the upload access policy does not permit
+func ErrUploadDenied(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeUploadDenied, fmt.Sprintf("%s is not allowed to upload wasm code", addr))
+}
+
+// ErrInstantiateDenied error for an instantiation by an address the code's instantiate permission does not permit
+func ErrInstantiateDenied(addr sdk.AccAddress, codeID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInstantiateDenied, fmt.Sprintf("%s is not allowed to instantiate code %d", addr, codeID))
+}
@@ -6,11 +6,16 @@ const (
 	EventTypeStoreCode           = "store_code"
 	EventTypeInstantiateContract = "instantiate_contract"
 	EventTypeExecuteContract     = "execute_contract"
+	EventTypeUpdateContractAdmin = "update_contract_admin"
+	EventTypeClearContractAdmin  = "clear_contract_admin"
+	EventTypeFromContract        = "from_contract"
 
 	AttributeKeySender          = "sender"
 	AttributeKeyCodeID          = "code_id"
 	AttributeKeyContractAddress = "contract_address"
 	AttributeKeyContractID      = "contract_id"
+	AttributeKeyAdmin           = "admin"
+	AttributeKeyLog             = "log"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SupplyKeeper expected supply keeper
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+}
+
+// TreasuryKeeper expected treasury keeper
+type TreasuryKeeper interface {
+	GetDenomTaxRate(ctx sdk.Context, denom string) (rate sdk.Dec)
+	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
+	RecordTaxPayment(ctx sdk.Context, payer sdk.AccAddress, taxes sdk.Coins)
+	IsTaxExempt(ctx sdk.Context, sender, recipient sdk.AccAddress, denom string) bool
+}
@@ -1,16 +1,22 @@
 package types
 
+import "fmt"
+
 // GenesisState is the struct representation of the export genesis
 type GenesisState struct {
 	Params    Params     `json:"params" yaml:"params"`
 	Codes     []Code     `json:"codes" yaml:"codes"`
 	Contracts []Contract `json:"contracts" yaml:"contracts"`
+	// LastCodeID and LastInstanceID are the last assigned IDs of the code and instance sequences
+	LastCodeID     uint64 `json:"last_code_id" yaml:"last_code_id"`
+	LastInstanceID uint64 `json:"last_instance_id" yaml:"last_instance_id"`
 }
 
-// Code struct encompasses CodeInfo and CodeBytes
+// Code struct encompasses CodeInfo, CodeBytes and the code ID they are stored under
 type Code struct {
 	CodeInfo   CodeInfo `json:"code_info"`
 	CodesBytes []byte   `json:"code_bytes"`
+	CodeID     uint64   `json:"code_id"`
 }
 
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
@@ -20,11 +26,13 @@ type Contract struct {
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, codes []Code, contracts []Contract) GenesisState {
+func NewGenesisState(params Params, codes []Code, contracts []Contract, lastCodeID uint64, lastInstanceID uint64) GenesisState {
 	return GenesisState{
-		Params:    params,
-		Codes:     codes,
-		Contracts: contracts,
+		Params:         params,
+		Codes:          codes,
+		Contracts:      contracts,
+		LastCodeID:     lastCodeID,
+		LastInstanceID: lastInstanceID,
 	}
 }
 
@@ -40,5 +48,67 @@ func DefaultGenesisState() GenesisState {
 // ValidateGenesis performs basic validation of wasm genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	codeIDs := make(map[uint64]bool, len(data.Codes))
+	for _, code := range data.Codes {
+		if code.CodeID == 0 || code.CodeID > data.LastCodeID {
+			return fmt.Errorf("code ID %d of code %X is out of the range 1 to %d", code.CodeID, code.CodeInfo.CodeHash, data.LastCodeID)
+		}
+		if codeIDs[code.CodeID] {
+			return fmt.Errorf("duplicate code ID %d", code.CodeID)
+		}
+		codeIDs[code.CodeID] = true
+
+		if err := code.CodeInfo.InstantiatePermission.Validate(); err != nil {
+			return fmt.Errorf("invalid instantiate permission of code %X: %s", code.CodeInfo.CodeHash, err)
+		}
+	}
+
+	contractAddrs := make(map[string]bool, len(data.Contracts))
+	for _, contract := range data.Contracts {
+		addr := contract.ContractInfo.Address.String()
+		if contractAddrs[addr] {
+			return fmt.Errorf("duplicate contract address %s", addr)
+		}
+		contractAddrs[addr] = true
+
+		// contracts instantiated before labels were introduced have none
+		if len(contract.ContractInfo.Label) > MaxLabelSize {
+			return fmt.Errorf("label of contract %s is too long", addr)
+		}
+
+		if !codeIDs[contract.ContractInfo.CodeID] {
+			return fmt.Errorf("contract %s uses unknown code %d", addr, contract.ContractInfo.CodeID)
+		}
+
+		if err := validateInstanceID(contract.ContractInfo, data.LastInstanceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateInstanceID checks that a counter addressed contract lives at the address derived from
+// the code it was instantiated with and its instance ID, and that the instance counter is past
+// that ID, so the next counter addressed contract cannot collide with it
+func validateInstanceID(info ContractInfo, lastInstanceID uint64) error {
+	if info.InstanceID == 0 {
+		return nil
+	}
+
+	if info.InstanceID > lastInstanceID {
+		return fmt.Errorf("instance ID %d of contract %s is above the last instance ID %d",
+			info.InstanceID, info.Address, lastInstanceID)
+	}
+
+	if !ContractAddress(info.CodeID, info.InstanceID).Equals(info.Address) {
+		return fmt.Errorf("address of contract %s is not derived from code %d and instance ID %d",
+			info.Address, info.CodeID, info.InstanceID)
+	}
+
 	return nil
 }
@@ -33,12 +33,18 @@ const (
 // - 0x04<accAddress_Bytes>: ContractInfo
 //
 // - 0x05<accAddress_Bytes>: KVStore for contract
+//
+// - 0x07<uint64><accAddress_Bytes>: []byte{} (contracts by code index)
+//
+// - 0x08<accAddress_Bytes><accAddress_Bytes>: []byte{} (contracts by creator index)
 var (
-	LastCodeIDKey     = []byte{0x01}
-	LastInstanceIDKey = []byte{0x02}
-	CodeKey           = []byte{0x03}
-	ContractInfoKey   = []byte{0x04}
-	ContractStoreKey  = []byte{0x05}
+	LastCodeIDKey         = []byte{0x01}
+	LastInstanceIDKey     = []byte{0x02}
+	CodeKey               = []byte{0x03}
+	ContractInfoKey       = []byte{0x04}
+	ContractStoreKey      = []byte{0x05}
+	ContractsByCodeKey    = []byte{0x07}
+	ContractsByCreatorKey = []byte{0x08}
 )
 
 // GetCodeInfoKey constructs the key of the WASM code info for the ID
@@ -56,3 +62,23 @@ func GetContractInfoKey(addr sdk.AccAddress) []byte {
 func GetContractStoreKey(addr sdk.AccAddress) []byte {
 	return append(ContractStoreKey, addr...)
 }
+
+// GetContractsByCodePrefix returns the prefix of the index of the contracts running the code
+func GetContractsByCodePrefix(codeID uint64) []byte {
+	return append(ContractsByCodeKey, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeKey returns the key of the contract in the index of the contracts running the code
+func GetContractByCodeKey(codeID uint64, addr sdk.AccAddress) []byte {
+	return append(GetContractsByCodePrefix(codeID), addr...)
+}
+
+// GetContractsByCreatorPrefix returns the prefix of the index of the contracts instantiated by the creator
+func GetContractsByCreatorPrefix(creator sdk.AccAddress) []byte {
+	return append(ContractsByCreatorKey, creator...)
+}
+
+// GetContractByCreatorKey returns the key of the contract in the index of the contracts instantiated by the creator
+func GetContractByCreatorKey(creator sdk.AccAddress, addr sdk.AccAddress) []byte {
+	return append(GetContractsByCreatorPrefix(creator), addr...)
+}
@@ -2,13 +2,28 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	treasuryexported "github.com/terra-project/core/x/treasury/exported"
+)
+
+// Assert the msgs transferring coins from the sender implement treasuryexported.TaxedMsg at compile-time
+var (
+	_ treasuryexported.TaxedMsg = MsgInstantiateContract{}
+	_ treasuryexported.TaxedMsg = MsgExecuteContract{}
 )
 
 const (
 	// MaxWasmSize 500 KB (hard-cap)
 	MaxWasmSize = 500 * 1024
+
+	// MaxLabelSize is the longest contract label accepted
+	MaxLabelSize = 128
+
+	// MaxSaltSize is the longest salt accepted for a predictable contract address
+	MaxSaltSize = 64
 )
 
 // MsgStoreCode - struct for upload contract wasm byte codes
@@ -16,13 +31,16 @@ type MsgStoreCode struct {
 	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
 	// WASMBytecode can be raw or gzip compressed
 	WASMBytecode []byte `json:"wasm_byte_code" yaml:"wasm_byte_code"`
+	// InstantiatePermission is optional; everybody may instantiate the code when it is nil
+	InstantiatePermission *AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
 }
 
 // NewMsgStoreCode creates a MsgStoreCode instance
-func NewMsgStoreCode(sender sdk.AccAddress, wasmBytecode []byte) MsgStoreCode {
+func NewMsgStoreCode(sender sdk.AccAddress, wasmBytecode []byte, instantiatePermission *AccessConfig) MsgStoreCode {
 	return MsgStoreCode{
-		Sender:       sender,
-		WASMBytecode: wasmBytecode,
+		Sender:                sender,
+		WASMBytecode:          wasmBytecode,
+		InstantiatePermission: instantiatePermission,
 	}
 }
 
@@ -54,24 +72,38 @@ func (msg MsgStoreCode) ValidateBasic() sdk.Error {
 	if len(msg.WASMBytecode) > MaxWasmSize {
 		return sdk.ErrInternal("wasm code too large")
 	}
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(err.Error())
+		}
+	}
 	return nil
 }
 
 // MsgInstantiateContract - struct for instantiate contract from uploaded code
 type MsgInstantiateContract struct {
-	Sender    sdk.AccAddress  `json:"sender" yaml:"sender"`
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	// Admin is optional
+	Admin     sdk.AccAddress  `json:"admin" yaml:"admin"`
 	CodeID    uint64          `json:"code_id" yaml:"code_id"`
 	InitMsg   json.RawMessage `json:"init_msg" yaml:"init_msg"`
 	InitCoins sdk.Coins       `json:"init_coins" yaml:"init_coins"`
+	Label     string          `json:"label" yaml:"label"`
+	// Salt is optional; when set the contract address is derived from the sender,
+	// the code hash and the salt instead of the instance counter
+	Salt []byte `json:"salt,omitempty" yaml:"salt"`
 }
 
 // NewMsgInstantiateContract creates a MsgInstantiateContract instance
-func NewMsgInstantiateContract(sender sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins) MsgInstantiateContract {
+func NewMsgInstantiateContract(sender, admin sdk.AccAddress, codeID uint64, label string, salt []byte, initMsg []byte, initCoins sdk.Coins) MsgInstantiateContract {
 	return MsgInstantiateContract{
 		Sender:    sender,
+		Admin:     admin,
 		CodeID:    codeID,
 		InitMsg:   initMsg,
 		InitCoins: initCoins,
+		Label:     label,
+		Salt:      salt,
 	}
 }
 
@@ -90,6 +122,12 @@ func (msg MsgInstantiateContract) ValidateBasic() sdk.Error {
 	if msg.InitCoins.IsAnyNegative() {
 		return sdk.ErrInvalidCoins("negative InitCoins")
 	}
+	if err := ValidateLabel(msg.Label); err != nil {
+		return err
+	}
+	if len(msg.Salt) > MaxSaltSize {
+		return sdk.ErrUnknownRequest("salt too long")
+	}
 	return nil
 }
 
@@ -103,6 +141,12 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+// GetTaxedTransfer implements treasuryexported.TaxedMsg; the contract address is not known
+// before instantiation
+func (msg MsgInstantiateContract) GetTaxedTransfer() (sender, recipient sdk.AccAddress, coins sdk.Coins) {
+	return msg.Sender, nil, msg.InitCoins
+}
+
 // MsgExecuteContract - struct for execute instantiated contract with givn inner msg bytes
 type MsgExecuteContract struct {
 	Sender   sdk.AccAddress  `json:"sender" yaml:"sender"`
@@ -148,3 +192,114 @@ func (msg MsgExecuteContract) GetSignBytes() []byte {
 func (msg MsgExecuteContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// GetTaxedTransfer implements treasuryexported.TaxedMsg
+func (msg MsgExecuteContract) GetTaxedTransfer() (sender, recipient sdk.AccAddress, coins sdk.Coins) {
+	return msg.Sender, msg.Contract, msg.Coins
+}
+
+// MsgUpdateContractAdmin - struct for hand over the admin of a contract
+type MsgUpdateContractAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+// NewMsgUpdateContractAdmin creates a MsgUpdateContractAdmin instance
+func NewMsgUpdateContractAdmin(admin, newAdmin, contract sdk.AccAddress) MsgUpdateContractAdmin {
+	return MsgUpdateContractAdmin{
+		Admin:    admin,
+		NewAdmin: newAdmin,
+		Contract: contract,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateContractAdmin) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpdateContractAdmin) Type() string {
+	return "updatecontractadmin"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateContractAdmin) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdk.ErrInvalidAddress("missing new admin address")
+	}
+	if msg.Contract.Empty() {
+		return sdk.ErrInvalidAddress("missing contract address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateContractAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateContractAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgClearContractAdmin - struct for removing the admin of a contract for good
+type MsgClearContractAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+// NewMsgClearContractAdmin creates a MsgClearContractAdmin instance
+func NewMsgClearContractAdmin(admin, contract sdk.AccAddress) MsgClearContractAdmin {
+	return MsgClearContractAdmin{
+		Admin:    admin,
+		Contract: contract,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClearContractAdmin) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgClearContractAdmin) Type() string {
+	return "clearcontractadmin"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClearContractAdmin) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.Contract.Empty() {
+		return sdk.ErrInvalidAddress("missing contract address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClearContractAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClearContractAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// ValidateLabel checks the contract label is set and not too long
+func ValidateLabel(label string) sdk.Error {
+	if len(strings.TrimSpace(label)) == 0 {
+		return sdk.ErrUnknownRequest("missing label")
+	}
+	if len(label) > MaxLabelSize {
+		return sdk.ErrUnknownRequest("label too long")
+	}
+	return nil
+}
@@ -11,9 +11,14 @@ const DefaultParamspace = ModuleName
 
 // Parameter keys
 var (
-	ParamStoreKeyMaxContractSize = []byte("maxcontractsize")
-	ParamStoreKeyMaxContractGas  = []byte("maxcontractgas")
-	ParamStoreKeyGasMultiplier   = []byte("gasmultiplier")
+	ParamStoreKeyMaxContractSize    = []byte("maxcontractsize")
+	ParamStoreKeyMaxContractGas     = []byte("maxcontractgas")
+	ParamStoreKeyGasMultiplier      = []byte("gasmultiplier")
+	ParamStoreKeyUploadAccess       = []byte("uploadaccess")
+	ParamStoreKeyCompileCostPerByte = []byte("compilecostperbyte")
+	ParamStoreKeyInstantiateCost    = []byte("instantiatecost")
+	ParamStoreKeyStoreCostPerByte   = []byte("storecostperbyte")
+	ParamStoreKeyDispatchCost       = []byte("dispatchcost")
 )
 
 // Default parameter values
@@ -24,30 +29,55 @@ var (
 	// Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)
 	DefaultMaxContractGas = uint64(900000000) // 900,000,000
 	DefaultGasMultiplier  = uint64(100)
+	DefaultUploadAccess   = AllowEverybody
+	// The costs below are charged in sdk gas on top of the gas the contract itself used
+	DefaultCompileCostPerByte = uint64(2)     // per byte of uncompressed bytecode
+	DefaultInstantiateCost    = uint64(40000) // per contract instance
+	DefaultStoreCostPerByte   = uint64(10)    // per key and value byte a contract reads or writes
+	DefaultDispatchCost       = uint64(1000)  // per msg a contract dispatches
+)
+
+// Upper bounds of the cost params; they keep the gas charged for a max size code,
+// a max gas contract call or its store access far below the 64 bit limit
+const (
+	MaxCompileCostPerByte = uint64(1000)
+	MaxInstantiateCost    = uint64(10000000)
+	MaxStoreCostPerByte   = uint64(10000)
+	MaxDispatchCost       = uint64(1000000)
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params wasm parameters
 type Params struct {
-	MaxContractSize int64  `json:"max_contract_size" yaml:"max_contract_size"` // allowed max contract bytes size
-	MaxContractGas  uint64 `json:"max_contract_gas" yaml:"max_contract_gas"`   // allowed max gas usages per each contract execution
-	GasMultiplier   uint64 `json:"gas_multiplier" yaml:"gas_multiplier"`       // defines how many cosmwasm gas points = 1 sdk gas point
+	MaxContractSize    int64        `json:"max_contract_size" yaml:"max_contract_size"`         // allowed max contract bytes size
+	MaxContractGas     uint64       `json:"max_contract_gas" yaml:"max_contract_gas"`           // allowed max gas usages per each contract execution
+	GasMultiplier      uint64       `json:"gas_multiplier" yaml:"gas_multiplier"`               // defines how many cosmwasm gas points = 1 sdk gas point
+	UploadAccess       AccessConfig `json:"upload_access" yaml:"upload_access"`                 // defines who is allowed to upload wasm code
+	CompileCostPerByte uint64       `json:"compile_cost_per_byte" yaml:"compile_cost_per_byte"` // sdk gas charged per byte of uploaded bytecode
+	InstantiateCost    uint64       `json:"instantiate_cost" yaml:"instantiate_cost"`           // sdk gas charged per contract instantiation
+	StoreCostPerByte   uint64       `json:"store_cost_per_byte" yaml:"store_cost_per_byte"`     // sdk gas charged per byte a contract reads from or writes to its store
+	DispatchCost       uint64       `json:"dispatch_cost" yaml:"dispatch_cost"`                 // sdk gas charged per msg a contract dispatches
 }
 
 // DefaultParams creates default treasury module parameters
 func DefaultParams() Params {
 	return Params{
-		MaxContractSize: DefaultMaxContractSize,
-		MaxContractGas:  DefaultMaxContractGas,
-		GasMultiplier:   DefaultGasMultiplier,
+		MaxContractSize:    DefaultMaxContractSize,
+		MaxContractGas:     DefaultMaxContractGas,
+		GasMultiplier:      DefaultGasMultiplier,
+		UploadAccess:       DefaultUploadAccess,
+		CompileCostPerByte: DefaultCompileCostPerByte,
+		InstantiateCost:    DefaultInstantiateCost,
+		StoreCostPerByte:   DefaultStoreCostPerByte,
+		DispatchCost:       DefaultDispatchCost,
 	}
 }
 
 // Validate params
 func (params Params) Validate() error {
-	if params.MaxContractSize < 1024 || params.MaxContractSize > 400*1024 {
-		return fmt.Errorf("max contract byte size %d should be between [1KB, 400KB]", params.MaxContractSize)
+	if params.MaxContractSize < 1024 || params.MaxContractSize > 500*1024 {
+		return fmt.Errorf("max contract byte size %d should be between [1KB, 500KB]", params.MaxContractSize)
 	}
 
 	if params.GasMultiplier <= 0 {
@@ -58,6 +88,26 @@ func (params Params) Validate() error {
 		return fmt.Errorf("max contract gas %d should be lager than 50,000", params.MaxContractGas/params.GasMultiplier)
 	}
 
+	if err := params.UploadAccess.Validate(); err != nil {
+		return fmt.Errorf("invalid upload access: %s", err)
+	}
+
+	if params.CompileCostPerByte > MaxCompileCostPerByte {
+		return fmt.Errorf("compile cost per byte %d should not be larger than %d", params.CompileCostPerByte, MaxCompileCostPerByte)
+	}
+
+	if params.InstantiateCost > MaxInstantiateCost {
+		return fmt.Errorf("instantiate cost %d should not be larger than %d", params.InstantiateCost, MaxInstantiateCost)
+	}
+
+	if params.StoreCostPerByte > MaxStoreCostPerByte {
+		return fmt.Errorf("store cost per byte %d should not be larger than %d", params.StoreCostPerByte, MaxStoreCostPerByte)
+	}
+
+	if params.DispatchCost > MaxDispatchCost {
+		return fmt.Errorf("dispatch cost %d should not be larger than %d", params.DispatchCost, MaxDispatchCost)
+	}
+
 	return nil
 }
 
@@ -69,6 +119,11 @@ func (params *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: ParamStoreKeyMaxContractSize, Value: &params.MaxContractSize},
 		{Key: ParamStoreKeyMaxContractGas, Value: &params.MaxContractGas},
 		{Key: ParamStoreKeyGasMultiplier, Value: &params.GasMultiplier},
+		{Key: ParamStoreKeyUploadAccess, Value: &params.UploadAccess},
+		{Key: ParamStoreKeyCompileCostPerByte, Value: &params.CompileCostPerByte},
+		{Key: ParamStoreKeyInstantiateCost, Value: &params.InstantiateCost},
+		{Key: ParamStoreKeyStoreCostPerByte, Value: &params.StoreCostPerByte},
+		{Key: ParamStoreKeyDispatchCost, Value: &params.DispatchCost},
 	}
 }
 
@@ -79,5 +134,12 @@ func (params Params) String() string {
   Max Contract Gas         : %d
 
   Gas Multiplier  : %d
-  `, params.MaxContractSize, params.MaxContractGas, params.GasMultiplier)
+  Upload Access   : %s
+
+  Compile Cost Per Byte    : %d
+  Instantiate Cost         : %d
+  Store Cost Per Byte      : %d
+  Dispatch Cost            : %d
+  `, params.MaxContractSize, params.MaxContractGas, params.GasMultiplier, params.UploadAccess,
+		params.CompileCostPerByte, params.InstantiateCost, params.StoreCostPerByte, params.DispatchCost)
 }
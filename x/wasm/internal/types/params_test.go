@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.CompileCostPerByte = MaxCompileCostPerByte
+	params.InstantiateCost = MaxInstantiateCost
+	params.StoreCostPerByte = MaxStoreCostPerByte
+	params.DispatchCost = MaxDispatchCost
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.CompileCostPerByte = MaxCompileCostPerByte + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.InstantiateCost = MaxInstantiateCost + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StoreCostPerByte = MaxStoreCostPerByte + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.DispatchCost = MaxDispatchCost + 1
+	require.Error(t, params.Validate())
+}
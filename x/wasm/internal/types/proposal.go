@@ -0,0 +1,213 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/terra-project/core/x/gov"
+)
+
+const (
+	// ProposalTypeStoreCode defines the type for a StoreCodeProposal
+	ProposalTypeStoreCode = "StoreCode"
+
+	// ProposalTypeInstantiateContract defines the type for an InstantiateContractProposal
+	ProposalTypeInstantiateContract = "InstantiateContract"
+
+	// ProposalTypeExecuteContract defines the type for an ExecuteContractProposal
+	ProposalTypeExecuteContract = "ExecuteContract"
+)
+
+// Assert the wasm proposals implement govtypes.Content at compile-time
+var (
+	_ gov.Content = StoreCodeProposal{}
+	_ gov.Content = InstantiateContractProposal{}
+	_ gov.Content = ExecuteContractProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeStoreCode)
+	gov.RegisterProposalType(ProposalTypeInstantiateContract)
+	gov.RegisterProposalType(ProposalTypeExecuteContract)
+}
+
+// StoreCodeProposal uploads wasm code regardless of the upload access
+type StoreCodeProposal struct {
+	Title       string         `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string         `json:"description" yaml:"description"` // Description of the Proposal
+	RunAs       sdk.AccAddress `json:"run_as" yaml:"run_as"`           // creator of the code
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `json:"wasm_byte_code" yaml:"wasm_byte_code"`
+	// InstantiatePermission is optional; everybody may instantiate the code when it is nil
+	InstantiatePermission *AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+}
+
+// NewStoreCodeProposal creates a StoreCodeProposal.
+func NewStoreCodeProposal(title, description string, runAs sdk.AccAddress, wasmByteCode []byte, instantiatePermission *AccessConfig) StoreCodeProposal {
+	return StoreCodeProposal{title, description, runAs, wasmByteCode, instantiatePermission}
+}
+
+// GetTitle returns the title of a StoreCodeProposal.
+func (p StoreCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a StoreCodeProposal.
+func (p StoreCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a StoreCodeProposal.
+func (StoreCodeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a StoreCodeProposal.
+func (p StoreCodeProposal) ProposalType() string { return ProposalTypeStoreCode }
+
+// ValidateBasic runs basic stateless validity checks; the MaxContractSize param is
+// checked against the uncompressed code when the proposal is executed
+func (p StoreCodeProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if p.RunAs.Empty() {
+		return sdk.ErrInvalidAddress("missing run as address")
+	}
+
+	return NewMsgStoreCode(p.RunAs, p.WASMByteCode, p.InstantiatePermission).ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p StoreCodeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Store Code Proposal:
+  Title:                  %s
+  Description:            %s
+  RunAs:                  %s
+  WASMByteCode:           %d bytes
+  InstantiatePermission:  %v
+`, p.Title, p.Description, p.RunAs, len(p.WASMByteCode), p.InstantiatePermission))
+	return b.String()
+}
+
+// InstantiateContractProposal instantiates wasm code regardless of its instantiate permission;
+// it moves no coins, as the run as account signs nothing
+type InstantiateContractProposal struct {
+	Title       string         `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string         `json:"description" yaml:"description"` // Description of the Proposal
+	RunAs       sdk.AccAddress `json:"run_as" yaml:"run_as"`           // creator of the contract
+	// Admin is optional
+	Admin   sdk.AccAddress  `json:"admin" yaml:"admin"`
+	CodeID  uint64          `json:"code_id" yaml:"code_id"`
+	Label   string          `json:"label" yaml:"label"`
+	InitMsg json.RawMessage `json:"init_msg" yaml:"init_msg"`
+}
+
+// NewInstantiateContractProposal creates an InstantiateContractProposal.
+func NewInstantiateContractProposal(title, description string, runAs, admin sdk.AccAddress, codeID uint64,
+	label string, initMsg []byte) InstantiateContractProposal {
+	return InstantiateContractProposal{title, description, runAs, admin, codeID, label, initMsg}
+}
+
+// GetTitle returns the title of an InstantiateContractProposal.
+func (p InstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an InstantiateContractProposal.
+func (p InstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an InstantiateContractProposal.
+func (InstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an InstantiateContractProposal.
+func (p InstantiateContractProposal) ProposalType() string { return ProposalTypeInstantiateContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p InstantiateContractProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if p.RunAs.Empty() {
+		return sdk.ErrInvalidAddress("missing run as address")
+	}
+
+	if p.CodeID == 0 {
+		return sdk.ErrUnknownRequest("missing code id")
+	}
+
+	return NewMsgInstantiateContract(p.RunAs, p.Admin, p.CodeID, p.Label, nil, p.InitMsg, nil).ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p InstantiateContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Instantiate Contract Proposal:
+  Title:        %s
+  Description:  %s
+  RunAs:        %s
+  Admin:        %s
+  CodeID:       %d
+  Label:        %s
+  InitMsg:      %s
+`, p.Title, p.Description, p.RunAs, p.Admin, p.CodeID, p.Label, hex.EncodeToString(p.InitMsg)))
+	return b.String()
+}
+
+// ExecuteContractProposal executes a contract as the designated address;
+// it moves no coins, as the run as account signs nothing
+type ExecuteContractProposal struct {
+	Title       string          `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string          `json:"description" yaml:"description"` // Description of the Proposal
+	RunAs       sdk.AccAddress  `json:"run_as" yaml:"run_as"`           // sender of the execution
+	Contract    sdk.AccAddress  `json:"contract" yaml:"contract"`       // contract to execute
+	Msg         json.RawMessage `json:"msg" yaml:"msg"`
+}
+
+// NewExecuteContractProposal creates an ExecuteContractProposal.
+func NewExecuteContractProposal(title, description string, runAs, contract sdk.AccAddress, msg []byte) ExecuteContractProposal {
+	return ExecuteContractProposal{title, description, runAs, contract, msg}
+}
+
+// GetTitle returns the title of an ExecuteContractProposal.
+func (p ExecuteContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an ExecuteContractProposal.
+func (p ExecuteContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an ExecuteContractProposal.
+func (ExecuteContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ExecuteContractProposal.
+func (p ExecuteContractProposal) ProposalType() string { return ProposalTypeExecuteContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ExecuteContractProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if p.RunAs.Empty() {
+		return sdk.ErrInvalidAddress("missing run as address")
+	}
+
+	if p.Contract.Empty() {
+		return sdk.ErrInvalidAddress("missing contract address")
+	}
+
+	return NewMsgExecuteContract(p.RunAs, p.Contract, p.Msg, nil).ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p ExecuteContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Execute Contract Proposal:
+  Title:        %s
+  Description:  %s
+  RunAs:        %s
+  Contract:     %s
+  Msg:          %s
+`, p.Title, p.Description, p.RunAs, p.Contract, hex.EncodeToString(p.Msg)))
+	return b.String()
+}
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // query endpoints supported by the wasm Querier
 const (
@@ -9,6 +14,19 @@ const (
 	QueryGetContractInfo = "contractInfo"
 	QueryGetStore        = "store"
 	QueryGetMsg          = "msg"
+
+	QueryListCodes              = "codes"
+	QueryListContractsByCode    = "contractsByCode"
+	QueryListContractsByCreator = "contractsByCreator"
+	QueryListModels             = "models"
+)
+
+// page sizes of the listing queries
+const (
+	// DefaultQueryLimit is the page size used when no limit is given
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the largest page size a listing query accepts
+	MaxQueryLimit = 1000
 )
 
 // QueryCodeIDParams defines the params for the following queries:
@@ -57,3 +75,107 @@ type QueryMsgParams struct {
 func NewQueryMsgParams(contractAddress sdk.AccAddress, msg []byte) QueryMsgParams {
 	return QueryMsgParams{contractAddress, msg}
 }
+
+// QueryListParams defines the params for the following queries:
+// - 'custom/wasm/codes'
+type QueryListParams struct {
+	Page  int
+	Limit int
+}
+
+// NewQueryListParams returns QueryListParams instance
+func NewQueryListParams(page, limit int) QueryListParams {
+	return QueryListParams{page, limit}
+}
+
+// QueryContractsByCodeParams defines the params for the following queries:
+// - 'custom/wasm/contractsByCode'
+type QueryContractsByCodeParams struct {
+	CodeID uint64
+	Page   int
+	Limit  int
+}
+
+// NewQueryContractsByCodeParams returns QueryContractsByCodeParams instance
+func NewQueryContractsByCodeParams(codeID uint64, page, limit int) QueryContractsByCodeParams {
+	return QueryContractsByCodeParams{codeID, page, limit}
+}
+
+// QueryContractsByCreatorParams defines the params for the following queries:
+// - 'custom/wasm/contractsByCreator'
+type QueryContractsByCreatorParams struct {
+	Creator sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+// NewQueryContractsByCreatorParams returns QueryContractsByCreatorParams instance
+func NewQueryContractsByCreatorParams(creator sdk.AccAddress, page, limit int) QueryContractsByCreatorParams {
+	return QueryContractsByCreatorParams{creator, page, limit}
+}
+
+// QueryModelsParams defines the params for the following queries:
+// - 'custom/wasm/models'
+type QueryModelsParams struct {
+	ContractAddress sdk.AccAddress
+	StartKey        []byte
+	Limit           int
+}
+
+// NewQueryModelsParams returns QueryModelsParams instance
+func NewQueryModelsParams(contractAddress sdk.AccAddress, startKey []byte, limit int) QueryModelsParams {
+	return QueryModelsParams{contractAddress, startKey, limit}
+}
+
+// CodeInfoResponse is an entry of the code listing
+type CodeInfoResponse struct {
+	CodeID   uint64         `json:"code_id"`
+	CodeHash []byte         `json:"code_hash"`
+	Creator  sdk.AccAddress `json:"creator"`
+}
+
+// String implements fmt.Stringer interface
+func (res CodeInfoResponse) String() string {
+	return fmt.Sprintf(`CodeInfo
+	CodeID:   %d,
+	CodeHash: %X,
+	Creator:  %s`,
+		res.CodeID, res.CodeHash, res.Creator)
+}
+
+// CodeInfoResponses is a page of the code listing
+type CodeInfoResponses []CodeInfoResponse
+
+// String implements fmt.Stringer interface
+func (res CodeInfoResponses) String() (out string) {
+	for _, code := range res {
+		out += code.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ContractInfos is a page of a contract listing
+type ContractInfos []ContractInfo
+
+// String implements fmt.Stringer interface
+func (cis ContractInfos) String() (out string) {
+	for _, contract := range cis {
+		out += contract.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ModelsResponse is a page of the models of a contract store; NextKey is the
+// start key of the next page, empty on the last page
+type ModelsResponse struct {
+	Models  []Model `json:"models"`
+	NextKey []byte  `json:"next_key"`
+}
+
+// String implements fmt.Stringer interface
+func (res ModelsResponse) String() (out string) {
+	for _, model := range res.Models {
+		out += model.String() + "\n"
+	}
+	return out + fmt.Sprintf("NextKey: %X", res.NextKey)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReplyOn values of a SubMsg
+const (
+	ReplyAlways  = "always"
+	ReplySuccess = "success"
+	ReplyError   = "error"
+	ReplyNever   = "never"
+)
+
+// SubMsg is a message a contract dispatches in its own cache context. A failed
+// SubMsg reverts only its own state changes, and its result is sent back to the
+// contract as a ReplyMsg when ReplyOn asks for it. A failure that is not replied
+// fails the contract call as a whole.
+type SubMsg struct {
+	ID      uint64              `json:"id"`
+	Msg     wasmTypes.CosmosMsg `json:"msg"`
+	ReplyOn string              `json:"reply_on"`
+}
+
+// ReplyOnSuccess returns true if the contract wants the result of a successful SubMsg
+func (msg SubMsg) ReplyOnSuccess() bool {
+	return msg.ReplyOn == ReplyAlways || msg.ReplyOn == ReplySuccess
+}
+
+// ReplyOnError returns true if the contract handles the failure of the SubMsg itself
+func (msg SubMsg) ReplyOnError() bool {
+	return msg.ReplyOn == ReplyAlways || msg.ReplyOn == ReplyError
+}
+
+// ValidateReplyOn checks the ReplyOn value is known
+func (msg SubMsg) ValidateReplyOn() sdk.Error {
+	switch msg.ReplyOn {
+	case ReplyAlways, ReplySuccess, ReplyError, ReplyNever:
+		return nil
+	}
+
+	return sdk.ErrUnknownRequest("invalid reply_on " + msg.ReplyOn)
+}
+
+// SubMsgResult is the outcome of a SubMsg, with exactly one of the fields set
+type SubMsgResult struct {
+	Ok  *SubMsgExecutionResponse `json:"ok,omitempty"`
+	Err string                   `json:"error,omitempty"`
+}
+
+// SubMsgExecutionResponse holds the events and data of a successful SubMsg
+type SubMsgExecutionResponse struct {
+	Events sdk.StringEvents `json:"events"`
+	Data   []byte           `json:"data,omitempty"`
+}
+
+// Reply is the result of the SubMsg with the given ID
+type Reply struct {
+	ID     uint64       `json:"id"`
+	Result SubMsgResult `json:"result"`
+}
+
+// ReplyMsg is the execute msg the result of a SubMsg is sent back with, with the
+// contract itself as the sender. The msg is reserved, so neither users nor
+// contracts can execute a contract with it.
+type ReplyMsg struct {
+	Reply Reply `json:"reply"`
+}
+
+// IsReplyMsg returns true if the execute msg is a JSON object with a reply field
+func IsReplyMsg(msg []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return false
+	}
+
+	_, found := fields["reply"]
+	return found
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	wasmTypes "github.com/confio/go-cosmwasm/types"
+)
+
+// TerraMsg is a Terra specific message a contract can emit through the opaque message
+// channel, as base64 encoded JSON. Exactly one of the fields must be set, and every
+// message is signed by the contract account. SubMsg wraps another message whose
+// result is replied to the contract.
+type TerraMsg struct {
+	Swap                    *SwapMsg                    `json:"swap,omitempty"`
+	Delegate                *DelegateMsg                `json:"delegate,omitempty"`
+	Undelegate              *UndelegateMsg              `json:"undelegate,omitempty"`
+	Redelegate              *RedelegateMsg              `json:"redelegate,omitempty"`
+	WithdrawDelegatorReward *WithdrawDelegatorRewardMsg `json:"withdraw_delegator_reward,omitempty"`
+	Vote                    *VoteMsg                    `json:"vote,omitempty"`
+	SubMsg                  *SubMsg                     `json:"sub_msg,omitempty"`
+}
+
+// SwapMsg swaps the offer coin to the ask denom through the market module
+type SwapMsg struct {
+	OfferCoin wasmTypes.Coin `json:"offer_coin"`
+	AskDenom  string         `json:"ask_denom"`
+}
+
+// DelegateMsg delegates the amount to the validator
+type DelegateMsg struct {
+	Validator string         `json:"validator"`
+	Amount    wasmTypes.Coin `json:"amount"`
+}
+
+// UndelegateMsg undelegates the amount from the validator
+type UndelegateMsg struct {
+	Validator string         `json:"validator"`
+	Amount    wasmTypes.Coin `json:"amount"`
+}
+
+// RedelegateMsg moves the amount from the source validator to the destination validator
+type RedelegateMsg struct {
+	SrcValidator string         `json:"src_validator"`
+	DstValidator string         `json:"dst_validator"`
+	Amount       wasmTypes.Coin `json:"amount"`
+}
+
+// WithdrawDelegatorRewardMsg withdraws the delegation rewards from the validator
+type WithdrawDelegatorRewardMsg struct {
+	Validator string `json:"validator"`
+}
+
+// VoteMsg votes on the governance proposal; option is one of
+// "yes", "no", "abstain" and "no_with_veto"
+type VoteMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Option     string `json:"option"`
+}
@@ -0,0 +1,60 @@
+// nolint
+package v03
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "wasm"
+)
+
+type (
+	// Params wasm parameters
+	Params struct {
+		MaxContractSize int64  `json:"max_contract_size" yaml:"max_contract_size"`
+		MaxContractGas  uint64 `json:"max_contract_gas" yaml:"max_contract_gas"`
+		GasMultiplier   uint64 `json:"gas_multiplier" yaml:"gas_multiplier"`
+	}
+
+	// CodeInfo is data for the uploaded contract WASM code
+	CodeInfo struct {
+		CodeHash []byte         `json:"code_hash"`
+		Creator  sdk.AccAddress `json:"creator"`
+	}
+
+	// ContractInfo stores a WASM contract instance
+	ContractInfo struct {
+		CodeID  uint64         `json:"code_id"`
+		Address sdk.AccAddress `json:"address"`
+		Creator sdk.AccAddress `json:"creator"`
+		InitMsg []byte         `json:"init_msg"`
+	}
+
+	// Model is a KV pair of a contract store, kept as strings
+	Model struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	// Code struct encompasses CodeInfo and CodeBytes; codes are exported
+	// in code ID order, the first one having code ID 1
+	Code struct {
+		CodeInfo   CodeInfo `json:"code_info"`
+		CodesBytes []byte   `json:"code_bytes"`
+	}
+
+	// Contract struct encompasses ContractInfo and ContractState
+	Contract struct {
+		ContractInfo  ContractInfo `json:"contract_info"`
+		ContractStore []Model      `json:"contract_store"`
+	}
+
+	// GenesisState - all wasm state exported by the wasm module that preceded v0.4;
+	// the code and instance sequences are not exported
+	GenesisState struct {
+		Params    Params     `json:"params" yaml:"params"`
+		Codes     []Code     `json:"codes" yaml:"codes"`
+		Contracts []Contract `json:"contracts" yaml:"contracts"`
+	}
+)
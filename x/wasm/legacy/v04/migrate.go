@@ -0,0 +1,77 @@
+package v04
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm"
+	v03wasm "github.com/terra-project/core/x/wasm/legacy/v03"
+)
+
+// Migrate accepts exported genesis state of the wasm module that preceded v0.4 and
+// migrates it to v0.4 genesis state. Codes get the code IDs of their export order and
+// contracts get the instance IDs their addresses were derived from. Contracts get no
+// admin and params introduced since are filled with their defaults. An error is returned
+// for a contract whose address is not derived from its code.
+func Migrate(oldGenState v03wasm.GenesisState) (wasm.GenesisState, error) {
+	params := wasm.Params{
+		MaxContractSize:    oldGenState.Params.MaxContractSize,
+		MaxContractGas:     oldGenState.Params.MaxContractGas,
+		GasMultiplier:      oldGenState.Params.GasMultiplier,
+		UploadAccess:       wasm.DefaultUploadAccess,
+		CompileCostPerByte: wasm.DefaultCompileCostPerByte,
+		InstantiateCost:    wasm.DefaultInstantiateCost,
+		StoreCostPerByte:   wasm.DefaultStoreCostPerByte,
+		DispatchCost:       wasm.DefaultDispatchCost,
+	}
+
+	// any account could instantiate any code before instantiate permissions were introduced
+	codes := make([]wasm.Code, len(oldGenState.Codes))
+	for i, code := range oldGenState.Codes {
+		codes[i] = wasm.Code{
+			CodeInfo:   wasm.NewCodeInfo(code.CodeInfo.CodeHash, code.CodeInfo.Creator, wasm.AllowEverybody),
+			CodesBytes: code.CodesBytes,
+			CodeID:     uint64(i + 1),
+		}
+	}
+
+	lastInstanceID := uint64(0)
+	contracts := make([]wasm.Contract, len(oldGenState.Contracts))
+	for i, contract := range oldGenState.Contracts {
+		info := contract.ContractInfo
+		instanceID, err := recoverInstanceID(info.CodeID, info.Address, uint64(len(oldGenState.Contracts)))
+		if err != nil {
+			return wasm.GenesisState{}, err
+		}
+
+		if instanceID > lastInstanceID {
+			lastInstanceID = instanceID
+		}
+
+		models := make([]wasm.Model, len(contract.ContractStore))
+		for j, model := range contract.ContractStore {
+			models[j] = wasm.Model{Key: []byte(model.Key), Value: []byte(model.Value)}
+		}
+
+		contracts[i] = wasm.Contract{
+			ContractInfo:  wasm.NewContractInfo(info.CodeID, instanceID, info.Address, info.Creator, nil, "", info.InitMsg),
+			ContractStore: models,
+		}
+	}
+
+	return wasm.NewGenesisState(params, codes, contracts, uint64(len(codes)), lastInstanceID), nil
+}
+
+// recoverInstanceID finds the instance ID the contract address was derived from. The instance
+// counter was neither exported nor imported, so it restarted with every import and never went
+// past the number of contracts.
+func recoverInstanceID(codeID uint64, addr sdk.AccAddress, maxInstanceID uint64) (uint64, error) {
+	for instanceID := uint64(1); instanceID <= maxInstanceID; instanceID++ {
+		if wasm.ContractAddress(codeID, instanceID).Equals(addr) {
+			return instanceID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("address of contract %s is not derived from code %d", addr, codeID)
+}
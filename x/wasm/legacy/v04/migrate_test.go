@@ -0,0 +1,78 @@
+package v04
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm"
+	v03wasm "github.com/terra-project/core/x/wasm/legacy/v03"
+)
+
+func TestMigrate(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	code := []byte{0x00, 0x61, 0x73, 0x6d}
+
+	// the instance counter restarted at 1 with every import, so instance IDs repeat across codes
+	oldGenState := v03wasm.GenesisState{
+		Params: v03wasm.Params{
+			MaxContractSize: 1024,
+			MaxContractGas:  wasm.DefaultMaxContractGas,
+			GasMultiplier:   wasm.DefaultGasMultiplier,
+		},
+		Codes: []v03wasm.Code{
+			{CodeInfo: v03wasm.CodeInfo{CodeHash: []byte("hash1"), Creator: creator}, CodesBytes: code},
+			{CodeInfo: v03wasm.CodeInfo{CodeHash: []byte("hash2"), Creator: creator}, CodesBytes: code},
+		},
+		Contracts: []v03wasm.Contract{
+			{
+				ContractInfo:  v03wasm.ContractInfo{CodeID: 1, Address: wasm.ContractAddress(1, 1), Creator: creator, InitMsg: []byte("{}")},
+				ContractStore: []v03wasm.Model{{Key: "count", Value: "1"}},
+			},
+			{
+				ContractInfo: v03wasm.ContractInfo{CodeID: 2, Address: wasm.ContractAddress(2, 2), Creator: creator, InitMsg: []byte("{}")},
+			},
+			{
+				ContractInfo: v03wasm.ContractInfo{CodeID: 2, Address: wasm.ContractAddress(2, 1), Creator: creator, InitMsg: []byte("{}")},
+			},
+		},
+	}
+
+	genState, err := Migrate(oldGenState)
+	require.NoError(t, err)
+	require.NoError(t, wasm.ValidateGenesis(genState))
+
+	require.Equal(t, int64(1024), genState.Params.MaxContractSize)
+	require.Equal(t, wasm.DefaultCompileCostPerByte, genState.Params.CompileCostPerByte)
+
+	require.Equal(t, uint64(2), genState.LastCodeID)
+	require.Equal(t, uint64(1), genState.Codes[0].CodeID)
+	require.Equal(t, uint64(2), genState.Codes[1].CodeID)
+
+	require.Equal(t, uint64(2), genState.LastInstanceID)
+	require.Equal(t, uint64(1), genState.Contracts[0].ContractInfo.InstanceID)
+	require.Equal(t, uint64(2), genState.Contracts[1].ContractInfo.InstanceID)
+	require.Equal(t, uint64(1), genState.Contracts[2].ContractInfo.InstanceID)
+	require.Nil(t, genState.Contracts[0].ContractInfo.Admin)
+	require.Equal(t, []wasm.Model{{Key: []byte("count"), Value: []byte("1")}}, genState.Contracts[0].ContractStore)
+}
+
+func TestMigrateUnknownAddress(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	oldGenState := v03wasm.GenesisState{
+		Params: v03wasm.Params{MaxContractSize: 1024, MaxContractGas: 1, GasMultiplier: 1},
+		Codes: []v03wasm.Code{
+			{CodeInfo: v03wasm.CodeInfo{CodeHash: []byte("hash1"), Creator: creator}, CodesBytes: []byte{0x00}},
+		},
+		Contracts: []v03wasm.Contract{
+			{ContractInfo: v03wasm.ContractInfo{CodeID: 1, Address: creator, Creator: creator}},
+		},
+	}
+
+	_, err := Migrate(oldGenState)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), creator.String())
+}
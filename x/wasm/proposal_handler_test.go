@@ -0,0 +1,109 @@
+package wasm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func TestStoreCodeProposalHandler(t *testing.T) {
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+	_, _, creator := keyPubAddr()
+
+	// uploads are gated, which the proposal bypasses
+	params := data.keeper.GetParams(data.ctx)
+	params.UploadAccess = types.AllowNobody
+	params.MaxContractSize = int64(len(testContract) - 1)
+	data.keeper.SetParams(data.ctx, params)
+
+	proposal := NewStoreCodeProposal("Test", "description", creator, testContract, &types.AllowNobody)
+	require.NoError(t, proposal.ValidateBasic())
+	require.Error(t, hdlr(data.ctx, proposal))
+
+	// the size limit applies to the uncompressed code
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(testContract)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.Less(t, buf.Len(), len(testContract)-1)
+	require.Error(t, hdlr(data.ctx, NewStoreCodeProposal("Test", "description", creator, buf.Bytes(), &types.AllowNobody)))
+
+	params.MaxContractSize = int64(len(testContract))
+	data.keeper.SetParams(data.ctx, params)
+	require.NoError(t, hdlr(data.ctx, proposal))
+
+	codeInfo, err := data.keeper.GetCodeInfo(data.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, creator, codeInfo.Creator)
+	require.Equal(t, types.AllowNobody, codeInfo.InstantiatePermission)
+
+	_, err = data.keeper.StoreCode(data.ctx, creator, escrowContract, types.AllowEverybody)
+	require.Error(t, err)
+
+	// stateless checks
+	require.Error(t, NewStoreCodeProposal("Test", "description", nil, testContract, nil).ValidateBasic())
+	require.Error(t, NewStoreCodeProposal("Test", "description", creator, nil, nil).ValidateBasic())
+	require.Error(t, NewStoreCodeProposal("Test", "description", creator, make([]byte, MaxWasmSize+1), nil).ValidateBasic())
+}
+
+func TestContractProposalHandlers(t *testing.T) {
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+	fred := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+	_, _, bob := keyPubAddr()
+
+	require.NoError(t, hdlr(data.ctx, NewStoreCodeProposal("Test", "description", creator, testContract, &types.AllowNobody)))
+	require.NoError(t, hdlr(data.ctx, NewStoreCodeProposal("Test", "description", creator, escrowContract, &types.AllowNobody)))
+
+	initMsgBz, err := json.Marshal(initMsg{Verifier: fred.String(), Beneficiary: bob.String()})
+	require.NoError(t, err)
+
+	// nobody may instantiate the code but governance, which instantiates without admin
+	_, sdkErr := data.keeper.InstantiateContract(data.ctx, creator, nil, 1, "demo contract", initMsgBz, nil)
+	require.Error(t, sdkErr)
+
+	// proposals move no coins of the run as account, which signs nothing
+	proposal := NewInstantiateContractProposal("Test", "description", creator, nil, 1, "demo contract", initMsgBz)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, hdlr(data.ctx, proposal))
+
+	var contractAddr sdk.AccAddress
+	data.keeper.IterateContractInfo(data.ctx, func(contract ContractInfo) bool {
+		contractAddr = contract.Address
+		return true
+	})
+	require.False(t, contractAddr.Empty())
+	require.True(t, data.acctKeeper.GetAccount(data.ctx, contractAddr).GetCoins().Empty())
+	require.Equal(t, deposit, data.acctKeeper.GetAccount(data.ctx, creator).GetCoins())
+
+	// release the funds as the verifier
+	contractAcc := data.acctKeeper.GetAccount(data.ctx, contractAddr)
+	require.NoError(t, contractAcc.SetCoins(deposit))
+	data.acctKeeper.SetAccount(data.ctx, contractAcc)
+
+	proposal2 := NewExecuteContractProposal("Test", "description", fred, contractAddr, []byte("{}"))
+	require.NoError(t, proposal2.ValidateBasic())
+	require.NoError(t, hdlr(data.ctx, proposal2))
+	require.Equal(t, deposit, data.acctKeeper.GetAccount(data.ctx, bob).GetCoins())
+	require.Equal(t, deposit, data.acctKeeper.GetAccount(data.ctx, fred).GetCoins())
+
+	// stateless checks
+	require.Error(t, NewInstantiateContractProposal("Test", "description", creator, nil, 0, "demo contract", initMsgBz).ValidateBasic())
+	require.Error(t, NewInstantiateContractProposal("Test", "description", creator, nil, 1, "", initMsgBz).ValidateBasic())
+	require.Error(t, NewExecuteContractProposal("Test", "description", nil, contractAddr, []byte("{}")).ValidateBasic())
+}
@@ -0,0 +1,153 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-project/core/x/wasm"
+)
+
+// SimulateMsgStoreCode generates a MsgStoreCode uploading the given wasm code
+func SimulateMsgStoreCode(k wasm.Keeper, wasmCode []byte) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		acc := simulation.RandomAcc(r, accs)
+
+		msg := wasm.NewMsgStoreCode(acc.Address, wasmCode, nil)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+		ctx, write := ctx.CacheContext()
+		ok := wasm.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgInstantiateContract generates a MsgInstantiateContract of a random stored code
+// with random init coins; the init msg is that of the testdata contract
+func SimulateMsgInstantiateContract(ak auth.AccountKeeper, k wasm.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		nextCodeID := k.GetNextCodeID(ctx)
+		if nextCodeID == 1 {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, nil
+		}
+		codeID := uint64(simulation.RandIntBetween(r, 1, int(nextCodeID)))
+
+		acc := simulation.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, acc.Address)
+		if account == nil {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, nil
+		}
+
+		initMsg, err := json.Marshal(wasm.InitMsg{
+			Verifier:    simulation.RandomAcc(r, accs).Address,
+			Beneficiary: simulation.RandomAcc(r, accs).Address,
+		})
+		if err != nil {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, err
+		}
+
+		var admin sdk.AccAddress
+		if r.Intn(2) == 0 {
+			admin = simulation.RandomAcc(r, accs).Address
+		}
+
+		// half of the contracts get a predictable address
+		var salt []byte
+		if r.Intn(2) == 0 {
+			salt = []byte(simulation.RandStringOfLength(r, 10))
+		}
+
+		initCoins := randomCoins(r, account.SpendableCoins(ctx.BlockHeader().Time))
+		label := simulation.RandStringOfLength(r, 10)
+
+		msg := wasm.NewMsgInstantiateContract(acc.Address, admin, codeID, label, salt, initMsg, initCoins)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+		ctx, write := ctx.CacheContext()
+		ok := wasm.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgExecuteContract generates a MsgExecuteContract of a random contract; the
+// verifier of the testdata contract sends the msg releasing its funds, which needs the
+// bank sends to be enabled
+func SimulateMsgExecuteContract(ak auth.AccountKeeper, k wasm.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var contracts []wasm.ContractInfo
+		k.IterateContractInfo(ctx, func(contractInfo wasm.ContractInfo) bool {
+			contracts = append(contracts, contractInfo)
+			return false
+		})
+		if len(contracts) == 0 {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, nil
+		}
+		contract := contracts[r.Intn(len(contracts))]
+
+		var initMsg wasm.InitMsg
+		if err := json.Unmarshal(contract.InitMsg, &initMsg); err != nil || initMsg.Verifier.Empty() {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, nil
+		}
+
+		var coins sdk.Coins
+		if account := ak.GetAccount(ctx, initMsg.Verifier); account != nil {
+			coins = randomCoins(r, account.SpendableCoins(ctx.BlockHeader().Time))
+		}
+
+		// the contract releases its whole balance, which fails when there is nothing to send;
+		// the stability tax is taken out of the released coins, so any balance can be released
+		if contractAcc := ak.GetAccount(ctx, contract.Address); coins.Empty() &&
+			(contractAcc == nil || contractAcc.GetCoins().Empty()) {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, nil
+		}
+
+		msg := wasm.NewMsgExecuteContract(initMsg.Verifier, contract.Address, []byte("{}"), coins)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(wasm.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+		ctx, write := ctx.CacheContext()
+		ok := wasm.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// randomCoins returns a random portion of a random subset of the coins
+func randomCoins(r *rand.Rand, coins sdk.Coins) sdk.Coins {
+	var randCoins sdk.Coins
+	for _, coin := range coins {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		amt := simulation.RandomAmount(r, coin.Amount)
+		if amt.IsPositive() {
+			randCoins = append(randCoins, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return randCoins
+}
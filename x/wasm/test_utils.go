@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/spf13/viper"
 
 	wasmTypes "github.com/confio/go-cosmwasm/types"
@@ -17,8 +18,6 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
-
-	"github.com/terra-project/core/x/auth"
 )
 
 var (
@@ -92,9 +91,9 @@ func createFakeFundedAccount(ctx sdk.Context, am auth.AccountKeeper, coins sdk.C
 
 func assertContractStore(t *testing.T, models []Model, expected state) {
 	require.Equal(t, 1, len(models), "#v", models)
-	require.Equal(t, "config", models[0].Key)
+	require.Equal(t, []byte("config"), models[0].Key)
 
 	expectedBz, err := json.Marshal(expected)
 	require.NoError(t, err)
-	require.Equal(t, string(expectedBz), models[0].Value)
+	require.Equal(t, expectedBz, models[0].Value)
 }